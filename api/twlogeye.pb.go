@@ -22,10 +22,13 @@ const (
 )
 
 type NofifyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Start int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Level string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	// State or "open"
+	State         string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Assignee      string `protobuf:"bytes,5,opt,name=assignee,proto3" json:"assignee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +84,20 @@ func (x *NofifyRequest) GetLevel() string {
 	return ""
 }
 
+func (x *NofifyRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NofifyRequest) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
 type NotifyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
@@ -90,6 +107,15 @@ type NotifyResponse struct {
 	Tags          string                 `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
 	Src           string                 `protobuf:"bytes,6,opt,name=src,proto3" json:"src,omitempty"`
 	Log           string                 `protobuf:"bytes,7,opt,name=log,proto3" json:"log,omitempty"`
+	Delivery      []*NotifyDeliveryEnt   `protobuf:"bytes,8,rep,name=delivery,proto3" json:"delivery,omitempty"`
+	IncidentId    string                 `protobuf:"bytes,9,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Count         int32                  `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+	FirstSeen     int64                  `protobuf:"varint,11,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen      int64                  `protobuf:"varint,12,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Target        string                 `protobuf:"bytes,13,opt,name=target,proto3" json:"target,omitempty"`
+	State         string                 `protobuf:"bytes,14,opt,name=state,proto3" json:"state,omitempty"`
+	Assignee      string                 `protobuf:"bytes,15,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Actions       []*NotifyActionEnt     `protobuf:"bytes,16,rep,name=actions,proto3" json:"actions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,30 +199,102 @@ func (x *NotifyResponse) GetLog() string {
 	return ""
 }
 
-type LogRequest struct {
+func (x *NotifyResponse) GetDelivery() []*NotifyDeliveryEnt {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *NotifyResponse) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *NotifyResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NotifyResponse) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *NotifyResponse) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *NotifyResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NotifyResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NotifyResponse) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *NotifyResponse) GetActions() []*NotifyActionEnt {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type IncidentEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Logtype       string                 `protobuf:"bytes,3,opt,name=logtype,proto3" json:"logtype,omitempty"`
-	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Tags          string                 `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	Src           string                 `protobuf:"bytes,6,opt,name=src,proto3" json:"src,omitempty"`
+	Log           string                 `protobuf:"bytes,7,opt,name=log,proto3" json:"log,omitempty"`
+	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	FirstSeen     int64                  `protobuf:"varint,9,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen      int64                  `protobuf:"varint,10,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Closed        bool                   `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
+	State         string                 `protobuf:"bytes,12,opt,name=state,proto3" json:"state,omitempty"`
+	Assignee      string                 `protobuf:"bytes,13,opt,name=assignee,proto3" json:"assignee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogRequest) Reset() {
-	*x = LogRequest{}
+func (x *IncidentEnt) Reset() {
+	*x = IncidentEnt{}
 	mi := &file_twlogeye_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogRequest) String() string {
+func (x *IncidentEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogRequest) ProtoMessage() {}
+func (*IncidentEnt) ProtoMessage() {}
 
-func (x *LogRequest) ProtoReflect() protoreflect.Message {
+func (x *IncidentEnt) ProtoReflect() protoreflect.Message {
 	mi := &file_twlogeye_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -208,62 +306,128 @@ func (x *LogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
-func (*LogRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncidentEnt.ProtoReflect.Descriptor instead.
+func (*IncidentEnt) Descriptor() ([]byte, []int) {
 	return file_twlogeye_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LogRequest) GetStart() int64 {
+func (x *IncidentEnt) GetId() string {
 	if x != nil {
-		return x.Start
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *IncidentEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *LogRequest) GetEnd() int64 {
+func (x *IncidentEnt) GetFirstSeen() int64 {
 	if x != nil {
-		return x.End
+		return x.FirstSeen
 	}
 	return 0
 }
 
-func (x *LogRequest) GetLogtype() string {
+func (x *IncidentEnt) GetLastSeen() int64 {
 	if x != nil {
-		return x.Logtype
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *IncidentEnt) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *IncidentEnt) GetState() string {
+	if x != nil {
+		return x.State
 	}
 	return ""
 }
 
-func (x *LogRequest) GetSearch() string {
+func (x *IncidentEnt) GetAssignee() string {
 	if x != nil {
-		return x.Search
+		return x.Assignee
 	}
 	return ""
 }
 
-type LogResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Src           string                 `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
-	Log           string                 `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
+type NotifyStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// incident:<id> or notify:<time>:<rule id>
+	Target        string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	User          string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Assignee      string `protobuf:"bytes,4,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Comment       string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogResponse) Reset() {
-	*x = LogResponse{}
+func (x *NotifyStateRequest) Reset() {
+	*x = NotifyStateRequest{}
 	mi := &file_twlogeye_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogResponse) String() string {
+func (x *NotifyStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogResponse) ProtoMessage() {}
+func (*NotifyStateRequest) ProtoMessage() {}
 
-func (x *LogResponse) ProtoReflect() protoreflect.Message {
+func (x *NotifyStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_twlogeye_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -275,54 +439,69 @@ func (x *LogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogResponse.ProtoReflect.Descriptor instead.
-func (*LogResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotifyStateRequest.ProtoReflect.Descriptor instead.
+func (*NotifyStateRequest) Descriptor() ([]byte, []int) {
 	return file_twlogeye_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LogResponse) GetTime() int64 {
+func (x *NotifyStateRequest) GetTarget() string {
 	if x != nil {
-		return x.Time
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *LogResponse) GetSrc() string {
+func (x *NotifyStateRequest) GetUser() string {
 	if x != nil {
-		return x.Src
+		return x.User
 	}
 	return ""
 }
 
-func (x *LogResponse) GetLog() string {
+func (x *NotifyStateRequest) GetState() string {
 	if x != nil {
-		return x.Log
+		return x.State
 	}
 	return ""
 }
 
-type ControlResponse struct {
+func (x *NotifyStateRequest) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *NotifyStateRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type NotifyCommentEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ControlResponse) Reset() {
-	*x = ControlResponse{}
+func (x *NotifyCommentEnt) Reset() {
+	*x = NotifyCommentEnt{}
 	mi := &file_twlogeye_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ControlResponse) String() string {
+func (x *NotifyCommentEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlResponse) ProtoMessage() {}
+func (*NotifyCommentEnt) ProtoMessage() {}
 
-func (x *ControlResponse) ProtoReflect() protoreflect.Message {
+func (x *NotifyCommentEnt) ProtoReflect() protoreflect.Message {
 	mi := &file_twlogeye_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,45 +513,57 @@ func (x *ControlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlResponse.ProtoReflect.Descriptor instead.
-func (*ControlResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotifyCommentEnt.ProtoReflect.Descriptor instead.
+func (*NotifyCommentEnt) Descriptor() ([]byte, []int) {
 	return file_twlogeye_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ControlResponse) GetOk() bool {
+func (x *NotifyCommentEnt) GetTime() int64 {
 	if x != nil {
-		return x.Ok
+		return x.Time
 	}
-	return false
+	return 0
 }
 
-func (x *ControlResponse) GetMessage() string {
+func (x *NotifyCommentEnt) GetUser() string {
 	if x != nil {
-		return x.Message
+		return x.User
 	}
 	return ""
 }
 
-type Empty struct {
+func (x *NotifyCommentEnt) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type NotifyHistoryEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Assignee      string                 `protobuf:"bytes,4,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *NotifyHistoryEnt) Reset() {
+	*x = NotifyHistoryEnt{}
 	mi := &file_twlogeye_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *NotifyHistoryEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*NotifyHistoryEnt) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *NotifyHistoryEnt) ProtoReflect() protoreflect.Message {
 	mi := &file_twlogeye_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,78 +575,73 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotifyHistoryEnt.ProtoReflect.Descriptor instead.
+func (*NotifyHistoryEnt) Descriptor() ([]byte, []int) {
 	return file_twlogeye_proto_rawDescGZIP(), []int{5}
 }
 
-type IDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *IDRequest) Reset() {
-	*x = IDRequest{}
-	mi := &file_twlogeye_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NotifyHistoryEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
 }
 
-func (x *IDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NotifyHistoryEnt) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
 }
 
-func (*IDRequest) ProtoMessage() {}
-
-func (x *IDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[6]
+func (x *NotifyHistoryEnt) GetState() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use IDRequest.ProtoReflect.Descriptor instead.
-func (*IDRequest) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{6}
+func (x *NotifyHistoryEnt) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
 }
 
-func (x *IDRequest) GetId() string {
+func (x *NotifyHistoryEnt) GetComment() string {
 	if x != nil {
-		return x.Id
+		return x.Comment
 	}
 	return ""
 }
 
-type ReportRequest struct {
+type NotifyWorkflowEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Assignee      string                 `protobuf:"bytes,3,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Comments      []*NotifyCommentEnt    `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	History       []*NotifyHistoryEnt    `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	Updated       int64                  `protobuf:"varint,6,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportRequest) Reset() {
-	*x = ReportRequest{}
-	mi := &file_twlogeye_proto_msgTypes[7]
+func (x *NotifyWorkflowEnt) Reset() {
+	*x = NotifyWorkflowEnt{}
+	mi := &file_twlogeye_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportRequest) String() string {
+func (x *NotifyWorkflowEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportRequest) ProtoMessage() {}
+func (*NotifyWorkflowEnt) ProtoMessage() {}
 
-func (x *ReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[7]
+func (x *NotifyWorkflowEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,48 +652,80 @@ func (x *ReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
-func (*ReportRequest) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use NotifyWorkflowEnt.ProtoReflect.Descriptor instead.
+func (*NotifyWorkflowEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ReportRequest) GetStart() int64 {
+func (x *NotifyWorkflowEnt) GetTarget() string {
 	if x != nil {
-		return x.Start
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *ReportRequest) GetEnd() int64 {
+func (x *NotifyWorkflowEnt) GetState() string {
 	if x != nil {
-		return x.End
+		return x.State
+	}
+	return ""
+}
+
+func (x *NotifyWorkflowEnt) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *NotifyWorkflowEnt) GetComments() []*NotifyCommentEnt {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *NotifyWorkflowEnt) GetHistory() []*NotifyHistoryEnt {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *NotifyWorkflowEnt) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
 	}
 	return 0
 }
 
-type LogSummaryEnt struct {
+type NotifyDeliveryEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LogPattern    string                 `protobuf:"bytes,1,opt,name=log_pattern,json=logPattern,proto3" json:"log_pattern,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Dst           string                 `protobuf:"bytes,1,opt,name=dst,proto3" json:"dst,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Time          int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Escalation    int32                  `protobuf:"varint,6,opt,name=escalation,proto3" json:"escalation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogSummaryEnt) Reset() {
-	*x = LogSummaryEnt{}
-	mi := &file_twlogeye_proto_msgTypes[8]
+func (x *NotifyDeliveryEnt) Reset() {
+	*x = NotifyDeliveryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogSummaryEnt) String() string {
+func (x *NotifyDeliveryEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogSummaryEnt) ProtoMessage() {}
+func (*NotifyDeliveryEnt) ProtoMessage() {}
 
-func (x *LogSummaryEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[8]
+func (x *NotifyDeliveryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,54 +736,85 @@ func (x *LogSummaryEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogSummaryEnt.ProtoReflect.Descriptor instead.
-func (*LogSummaryEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use NotifyDeliveryEnt.ProtoReflect.Descriptor instead.
+func (*NotifyDeliveryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LogSummaryEnt) GetLogPattern() string {
+func (x *NotifyDeliveryEnt) GetDst() string {
 	if x != nil {
-		return x.LogPattern
+		return x.Dst
 	}
 	return ""
 }
 
-func (x *LogSummaryEnt) GetCount() int32 {
+func (x *NotifyDeliveryEnt) GetStatus() string {
 	if x != nil {
-		return x.Count
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotifyDeliveryEnt) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
 	}
 	return 0
 }
 
-type SyslogReportEnt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Normal        int32                  `protobuf:"varint,2,opt,name=normal,proto3" json:"normal,omitempty"`
-	Warn          int32                  `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error         int32                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
-	Patterns      int32                  `protobuf:"varint,5,opt,name=patterns,proto3" json:"patterns,omitempty"`
-	ErrPatterns   int32                  `protobuf:"varint,6,opt,name=err_patterns,json=errPatterns,proto3" json:"err_patterns,omitempty"`
-	TopList       []*LogSummaryEnt       `protobuf:"bytes,7,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
-	TopErrorList  []*LogSummaryEnt       `protobuf:"bytes,8,rep,name=top_error_list,json=topErrorList,proto3" json:"top_error_list,omitempty"`
+func (x *NotifyDeliveryEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *NotifyDeliveryEnt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NotifyDeliveryEnt) GetEscalation() int32 {
+	if x != nil {
+		return x.Escalation
+	}
+	return 0
+}
+
+type NotifyActionEnt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// ok,error or dry-run
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Exit code of exec or HTTP status code
+	Code   int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Output string `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
+	Error  string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Time   int64  `protobuf:"varint,7,opt,name=time,proto3" json:"time,omitempty"`
+	// Duration in milliseconds
+	Duration      int64 `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyslogReportEnt) Reset() {
-	*x = SyslogReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[9]
+func (x *NotifyActionEnt) Reset() {
+	*x = NotifyActionEnt{}
+	mi := &file_twlogeye_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyslogReportEnt) String() string {
+func (x *NotifyActionEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyslogReportEnt) ProtoMessage() {}
+func (*NotifyActionEnt) ProtoMessage() {}
 
-func (x *SyslogReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[9]
+func (x *NotifyActionEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,91 +825,101 @@ func (x *SyslogReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyslogReportEnt.ProtoReflect.Descriptor instead.
-func (*SyslogReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use NotifyActionEnt.ProtoReflect.Descriptor instead.
+func (*NotifyActionEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SyslogReportEnt) GetTime() int64 {
+func (x *NotifyActionEnt) GetName() string {
 	if x != nil {
-		return x.Time
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SyslogReportEnt) GetNormal() int32 {
+func (x *NotifyActionEnt) GetType() string {
 	if x != nil {
-		return x.Normal
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *SyslogReportEnt) GetWarn() int32 {
+func (x *NotifyActionEnt) GetStatus() string {
 	if x != nil {
-		return x.Warn
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *SyslogReportEnt) GetError() int32 {
+func (x *NotifyActionEnt) GetCode() int32 {
 	if x != nil {
-		return x.Error
+		return x.Code
 	}
 	return 0
 }
 
-func (x *SyslogReportEnt) GetPatterns() int32 {
+func (x *NotifyActionEnt) GetOutput() string {
 	if x != nil {
-		return x.Patterns
+		return x.Output
 	}
-	return 0
+	return ""
 }
 
-func (x *SyslogReportEnt) GetErrPatterns() int32 {
+func (x *NotifyActionEnt) GetError() string {
 	if x != nil {
-		return x.ErrPatterns
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *SyslogReportEnt) GetTopList() []*LogSummaryEnt {
+func (x *NotifyActionEnt) GetTime() int64 {
 	if x != nil {
-		return x.TopList
+		return x.Time
 	}
-	return nil
+	return 0
 }
 
-func (x *SyslogReportEnt) GetTopErrorList() []*LogSummaryEnt {
+func (x *NotifyActionEnt) GetDuration() int64 {
 	if x != nil {
-		return x.TopErrorList
+		return x.Duration
 	}
-	return nil
+	return 0
 }
 
-type TrapSummaryEnt struct {
+type NotifyOutboxEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sender        string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TrapType      string                 `protobuf:"bytes,2,opt,name=trap_type,json=trapType,proto3" json:"trap_type,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Dst           string                 `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	NotifyTime    int64                  `protobuf:"varint,3,opt,name=notify_time,json=notifyTime,proto3" json:"notify_time,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Level         string                 `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Src           string                 `protobuf:"bytes,7,opt,name=src,proto3" json:"src,omitempty"`
+	Created       int64                  `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"`
+	Attempts      int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastTry       int64                  `protobuf:"varint,10,opt,name=last_try,json=lastTry,proto3" json:"last_try,omitempty"`
+	NextTry       int64                  `protobuf:"varint,11,opt,name=next_try,json=nextTry,proto3" json:"next_try,omitempty"`
+	LastError     string                 `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Dead          bool                   `protobuf:"varint,13,opt,name=dead,proto3" json:"dead,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrapSummaryEnt) Reset() {
-	*x = TrapSummaryEnt{}
-	mi := &file_twlogeye_proto_msgTypes[10]
+func (x *NotifyOutboxEnt) Reset() {
+	*x = NotifyOutboxEnt{}
+	mi := &file_twlogeye_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrapSummaryEnt) String() string {
+func (x *NotifyOutboxEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrapSummaryEnt) ProtoMessage() {}
+func (*NotifyOutboxEnt) ProtoMessage() {}
 
-func (x *TrapSummaryEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[10]
+func (x *NotifyOutboxEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,123 +930,134 @@ func (x *TrapSummaryEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrapSummaryEnt.ProtoReflect.Descriptor instead.
-func (*TrapSummaryEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use NotifyOutboxEnt.ProtoReflect.Descriptor instead.
+func (*NotifyOutboxEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TrapSummaryEnt) GetSender() string {
+func (x *NotifyOutboxEnt) GetKey() string {
 	if x != nil {
-		return x.Sender
+		return x.Key
 	}
 	return ""
 }
 
-func (x *TrapSummaryEnt) GetTrapType() string {
+func (x *NotifyOutboxEnt) GetDst() string {
 	if x != nil {
-		return x.TrapType
+		return x.Dst
 	}
 	return ""
 }
 
-func (x *TrapSummaryEnt) GetCount() int32 {
+func (x *NotifyOutboxEnt) GetNotifyTime() int64 {
 	if x != nil {
-		return x.Count
+		return x.NotifyTime
 	}
 	return 0
 }
 
-type TrapReportEnt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Types         int32                  `protobuf:"varint,3,opt,name=types,proto3" json:"types,omitempty"`
-	TopList       []*TrapSummaryEnt      `protobuf:"bytes,4,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NotifyOutboxEnt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *TrapReportEnt) Reset() {
-	*x = TrapReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NotifyOutboxEnt) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
 }
 
-func (x *TrapReportEnt) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NotifyOutboxEnt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (*TrapReportEnt) ProtoMessage() {}
-
-func (x *TrapReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[11]
+func (x *NotifyOutboxEnt) GetSrc() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Src
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TrapReportEnt.ProtoReflect.Descriptor instead.
-func (*TrapReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{11}
+func (x *NotifyOutboxEnt) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
 }
 
-func (x *TrapReportEnt) GetTime() int64 {
+func (x *NotifyOutboxEnt) GetAttempts() int32 {
 	if x != nil {
-		return x.Time
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *TrapReportEnt) GetCount() int32 {
+func (x *NotifyOutboxEnt) GetLastTry() int64 {
 	if x != nil {
-		return x.Count
+		return x.LastTry
 	}
 	return 0
 }
 
-func (x *TrapReportEnt) GetTypes() int32 {
+func (x *NotifyOutboxEnt) GetNextTry() int64 {
 	if x != nil {
-		return x.Types
+		return x.NextTry
 	}
 	return 0
 }
 
-func (x *TrapReportEnt) GetTopList() []*TrapSummaryEnt {
+func (x *NotifyOutboxEnt) GetLastError() string {
 	if x != nil {
-		return x.TopList
+		return x.LastError
 	}
-	return nil
+	return ""
 }
 
-type NetflowPacketsSummaryEnt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Packets       int32                  `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+func (x *NotifyOutboxEnt) GetDead() bool {
+	if x != nil {
+		return x.Dead
+	}
+	return false
+}
+
+type NotifyEscalationEnt struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Policy string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Next step (1 is first escalation)
+	Step          int32    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	Next          int64    `protobuf:"varint,4,opt,name=next,proto3" json:"next,omitempty"`
+	Created       int64    `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	RuleId        string   `protobuf:"bytes,6,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Level         string   `protobuf:"bytes,7,opt,name=level,proto3" json:"level,omitempty"`
+	Title         string   `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Src           string   `protobuf:"bytes,9,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           []string `protobuf:"bytes,10,rep,name=dst,proto3" json:"dst,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetflowPacketsSummaryEnt) Reset() {
-	*x = NetflowPacketsSummaryEnt{}
-	mi := &file_twlogeye_proto_msgTypes[12]
+func (x *NotifyEscalationEnt) Reset() {
+	*x = NotifyEscalationEnt{}
+	mi := &file_twlogeye_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetflowPacketsSummaryEnt) String() string {
+func (x *NotifyEscalationEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetflowPacketsSummaryEnt) ProtoMessage() {}
+func (*NotifyEscalationEnt) ProtoMessage() {}
 
-func (x *NetflowPacketsSummaryEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[12]
+func (x *NotifyEscalationEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,100 +1068,107 @@ func (x *NetflowPacketsSummaryEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetflowPacketsSummaryEnt.ProtoReflect.Descriptor instead.
-func (*NetflowPacketsSummaryEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use NotifyEscalationEnt.ProtoReflect.Descriptor instead.
+func (*NotifyEscalationEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *NetflowPacketsSummaryEnt) GetKey() string {
+func (x *NotifyEscalationEnt) GetTarget() string {
 	if x != nil {
-		return x.Key
+		return x.Target
 	}
 	return ""
 }
 
-func (x *NetflowPacketsSummaryEnt) GetPackets() int32 {
+func (x *NotifyEscalationEnt) GetPolicy() string {
 	if x != nil {
-		return x.Packets
+		return x.Policy
 	}
-	return 0
+	return ""
 }
 
-type NetflowBytesSummaryEnt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Bytes         int64                  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NotifyEscalationEnt) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
 }
 
-func (x *NetflowBytesSummaryEnt) Reset() {
-	*x = NetflowBytesSummaryEnt{}
-	mi := &file_twlogeye_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NotifyEscalationEnt) GetNext() int64 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
 }
 
-func (x *NetflowBytesSummaryEnt) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NotifyEscalationEnt) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
 }
 
-func (*NetflowBytesSummaryEnt) ProtoMessage() {}
+func (x *NotifyEscalationEnt) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
 
-func (x *NetflowBytesSummaryEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[13]
+func (x *NotifyEscalationEnt) GetLevel() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Level
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use NetflowBytesSummaryEnt.ProtoReflect.Descriptor instead.
-func (*NetflowBytesSummaryEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{13}
+func (x *NotifyEscalationEnt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *NetflowBytesSummaryEnt) GetKey() string {
+func (x *NotifyEscalationEnt) GetSrc() string {
 	if x != nil {
-		return x.Key
+		return x.Src
 	}
 	return ""
 }
 
-func (x *NetflowBytesSummaryEnt) GetBytes() int64 {
+func (x *NotifyEscalationEnt) GetDst() []string {
 	if x != nil {
-		return x.Bytes
+		return x.Dst
 	}
-	return 0
+	return nil
 }
 
-type NetflowKeyCountEnt struct {
+type LogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Logtype       string                 `protobuf:"bytes,3,opt,name=logtype,proto3" json:"logtype,omitempty"`
+	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	Query         string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetflowKeyCountEnt) Reset() {
-	*x = NetflowKeyCountEnt{}
-	mi := &file_twlogeye_proto_msgTypes[14]
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	mi := &file_twlogeye_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetflowKeyCountEnt) String() string {
+func (x *LogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetflowKeyCountEnt) ProtoMessage() {}
+func (*LogRequest) ProtoMessage() {}
 
-func (x *NetflowKeyCountEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[14]
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,68 +1179,76 @@ func (x *NetflowKeyCountEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetflowKeyCountEnt.ProtoReflect.Descriptor instead.
-func (*NetflowKeyCountEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *NetflowKeyCountEnt) GetKey() string {
+func (x *LogRequest) GetStart() int64 {
 	if x != nil {
-		return x.Key
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *NetflowKeyCountEnt) GetCount() int32 {
+func (x *LogRequest) GetEnd() int64 {
 	if x != nil {
-		return x.Count
+		return x.End
 	}
 	return 0
 }
 
-type NetflowReportEnt struct {
-	state              protoimpl.MessageState      `protogen:"open.v1"`
-	Time               int64                       `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Packets            int64                       `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
-	Bytes              int64                       `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Macs               int32                       `protobuf:"varint,4,opt,name=macs,proto3" json:"macs,omitempty"`
-	Ips                int32                       `protobuf:"varint,5,opt,name=ips,proto3" json:"ips,omitempty"`
-	Flows              int32                       `protobuf:"varint,6,opt,name=flows,proto3" json:"flows,omitempty"`
-	Protocols          int32                       `protobuf:"varint,7,opt,name=protocols,proto3" json:"protocols,omitempty"`
-	Fumbles            int32                       `protobuf:"varint,8,opt,name=fumbles,proto3" json:"fumbles,omitempty"`
-	Hosts              int32                       `protobuf:"varint,9,opt,name=hosts,proto3" json:"hosts,omitempty"`
-	Locs               int32                       `protobuf:"varint,10,opt,name=locs,proto3" json:"locs,omitempty"`
-	Country            int32                       `protobuf:"varint,11,opt,name=country,proto3" json:"country,omitempty"`
-	TopMacPacketsList  []*NetflowPacketsSummaryEnt `protobuf:"bytes,12,rep,name=top_mac_packets_list,json=topMacPacketsList,proto3" json:"top_mac_packets_list,omitempty"`
-	TopMacBytesList    []*NetflowBytesSummaryEnt   `protobuf:"bytes,13,rep,name=top_mac_bytes_list,json=topMacBytesList,proto3" json:"top_mac_bytes_list,omitempty"`
-	TopIpPacketsList   []*NetflowPacketsSummaryEnt `protobuf:"bytes,14,rep,name=top_ip_packets_list,json=topIpPacketsList,proto3" json:"top_ip_packets_list,omitempty"`
-	TopIpBytesList     []*NetflowBytesSummaryEnt   `protobuf:"bytes,15,rep,name=top_ip_bytes_list,json=topIpBytesList,proto3" json:"top_ip_bytes_list,omitempty"`
-	TopFlowPacketsList []*NetflowPacketsSummaryEnt `protobuf:"bytes,16,rep,name=top_flow_packets_list,json=topFlowPacketsList,proto3" json:"top_flow_packets_list,omitempty"`
-	TopFlowBytesList   []*NetflowBytesSummaryEnt   `protobuf:"bytes,17,rep,name=top_flow_bytes_list,json=topFlowBytesList,proto3" json:"top_flow_bytes_list,omitempty"`
-	TopProtocolList    []*NetflowKeyCountEnt       `protobuf:"bytes,18,rep,name=top_protocol_list,json=topProtocolList,proto3" json:"top_protocol_list,omitempty"`
-	TopFumbleSrcList   []*NetflowKeyCountEnt       `protobuf:"bytes,19,rep,name=top_fumble_src_list,json=topFumbleSrcList,proto3" json:"top_fumble_src_list,omitempty"`
-	TopHostList        []*NetflowKeyCountEnt       `protobuf:"bytes,20,rep,name=top_host_list,json=topHostList,proto3" json:"top_host_list,omitempty"`
-	TopLocList         []*NetflowKeyCountEnt       `protobuf:"bytes,21,rep,name=top_loc_list,json=topLocList,proto3" json:"top_loc_list,omitempty"`
-	TopCountryList     []*NetflowKeyCountEnt       `protobuf:"bytes,22,rep,name=top_country_list,json=topCountryList,proto3" json:"top_country_list,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *LogRequest) GetLogtype() string {
+	if x != nil {
+		return x.Logtype
+	}
+	return ""
 }
 
-func (x *NetflowReportEnt) Reset() {
-	*x = NetflowReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[15]
+func (x *LogRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *LogRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type LogStatsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Start   int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End     int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Logtype string                 `protobuf:"bytes,3,opt,name=logtype,proto3" json:"logtype,omitempty"`
+	Query   string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	GroupBy []string               `protobuf:"bytes,5,rep,name=groupBy,proto3" json:"groupBy,omitempty"`
+	// Time bucket (sec) 0 is whole time range
+	Interval int64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Numeric fields for sum,avg,min,max
+	Fields        []string `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogStatsRequest) Reset() {
+	*x = LogStatsRequest{}
+	mi := &file_twlogeye_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetflowReportEnt) String() string {
+func (x *LogStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetflowReportEnt) ProtoMessage() {}
+func (*LogStatsRequest) ProtoMessage() {}
 
-func (x *NetflowReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[15]
+func (x *LogStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,189 +1259,294 @@ func (x *NetflowReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetflowReportEnt.ProtoReflect.Descriptor instead.
-func (*NetflowReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use LogStatsRequest.ProtoReflect.Descriptor instead.
+func (*LogStatsRequest) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NetflowReportEnt) GetTime() int64 {
+func (x *LogStatsRequest) GetStart() int64 {
 	if x != nil {
-		return x.Time
+		return x.Start
 	}
 	return 0
 }
 
-func (x *NetflowReportEnt) GetPackets() int64 {
+func (x *LogStatsRequest) GetEnd() int64 {
 	if x != nil {
-		return x.Packets
+		return x.End
 	}
 	return 0
 }
 
-func (x *NetflowReportEnt) GetBytes() int64 {
+func (x *LogStatsRequest) GetLogtype() string {
 	if x != nil {
-		return x.Bytes
+		return x.Logtype
 	}
-	return 0
+	return ""
 }
 
-func (x *NetflowReportEnt) GetMacs() int32 {
+func (x *LogStatsRequest) GetQuery() string {
 	if x != nil {
-		return x.Macs
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *NetflowReportEnt) GetIps() int32 {
+func (x *LogStatsRequest) GetGroupBy() []string {
 	if x != nil {
-		return x.Ips
+		return x.GroupBy
 	}
-	return 0
+	return nil
 }
 
-func (x *NetflowReportEnt) GetFlows() int32 {
+func (x *LogStatsRequest) GetInterval() int64 {
 	if x != nil {
-		return x.Flows
+		return x.Interval
 	}
 	return 0
 }
 
-func (x *NetflowReportEnt) GetProtocols() int32 {
+func (x *LogStatsRequest) GetFields() []string {
 	if x != nil {
-		return x.Protocols
+		return x.Fields
 	}
-	return 0
+	return nil
 }
 
-func (x *NetflowReportEnt) GetFumbles() int32 {
-	if x != nil {
-		return x.Fumbles
-	}
-	return 0
+type LogStatsEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Group         []string               `protobuf:"bytes,2,rep,name=group,proto3" json:"group,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Stats         []*LogFieldStatsEnt    `protobuf:"bytes,4,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetflowReportEnt) GetHosts() int32 {
-	if x != nil {
-		return x.Hosts
-	}
-	return 0
+func (x *LogStatsEnt) Reset() {
+	*x = LogStatsEnt{}
+	mi := &file_twlogeye_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetflowReportEnt) GetLocs() int32 {
+func (x *LogStatsEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStatsEnt) ProtoMessage() {}
+
+func (x *LogStatsEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[13]
 	if x != nil {
-		return x.Locs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *NetflowReportEnt) GetCountry() int32 {
+// Deprecated: Use LogStatsEnt.ProtoReflect.Descriptor instead.
+func (*LogStatsEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LogStatsEnt) GetTime() int64 {
 	if x != nil {
-		return x.Country
+		return x.Time
 	}
 	return 0
 }
 
-func (x *NetflowReportEnt) GetTopMacPacketsList() []*NetflowPacketsSummaryEnt {
+func (x *LogStatsEnt) GetGroup() []string {
 	if x != nil {
-		return x.TopMacPacketsList
+		return x.Group
 	}
 	return nil
 }
 
-func (x *NetflowReportEnt) GetTopMacBytesList() []*NetflowBytesSummaryEnt {
+func (x *LogStatsEnt) GetCount() int64 {
 	if x != nil {
-		return x.TopMacBytesList
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *NetflowReportEnt) GetTopIpPacketsList() []*NetflowPacketsSummaryEnt {
+func (x *LogStatsEnt) GetStats() []*LogFieldStatsEnt {
 	if x != nil {
-		return x.TopIpPacketsList
+		return x.Stats
 	}
 	return nil
 }
 
-func (x *NetflowReportEnt) GetTopIpBytesList() []*NetflowBytesSummaryEnt {
-	if x != nil {
-		return x.TopIpBytesList
-	}
-	return nil
+type LogFieldStatsEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Sum           float64                `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Avg           float64                `protobuf:"fixed64,4,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min           float64                `protobuf:"fixed64,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,6,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetflowReportEnt) GetTopFlowPacketsList() []*NetflowPacketsSummaryEnt {
+func (x *LogFieldStatsEnt) Reset() {
+	*x = LogFieldStatsEnt{}
+	mi := &file_twlogeye_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogFieldStatsEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFieldStatsEnt) ProtoMessage() {}
+
+func (x *LogFieldStatsEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[14]
 	if x != nil {
-		return x.TopFlowPacketsList
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NetflowReportEnt) GetTopFlowBytesList() []*NetflowBytesSummaryEnt {
+// Deprecated: Use LogFieldStatsEnt.ProtoReflect.Descriptor instead.
+func (*LogFieldStatsEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LogFieldStatsEnt) GetField() string {
 	if x != nil {
-		return x.TopFlowBytesList
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *NetflowReportEnt) GetTopProtocolList() []*NetflowKeyCountEnt {
+func (x *LogFieldStatsEnt) GetCount() int64 {
 	if x != nil {
-		return x.TopProtocolList
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *NetflowReportEnt) GetTopFumbleSrcList() []*NetflowKeyCountEnt {
+func (x *LogFieldStatsEnt) GetSum() float64 {
 	if x != nil {
-		return x.TopFumbleSrcList
+		return x.Sum
 	}
-	return nil
+	return 0
 }
 
-func (x *NetflowReportEnt) GetTopHostList() []*NetflowKeyCountEnt {
+func (x *LogFieldStatsEnt) GetAvg() float64 {
 	if x != nil {
-		return x.TopHostList
+		return x.Avg
 	}
-	return nil
+	return 0
 }
 
-func (x *NetflowReportEnt) GetTopLocList() []*NetflowKeyCountEnt {
+func (x *LogFieldStatsEnt) GetMin() float64 {
 	if x != nil {
-		return x.TopLocList
+		return x.Min
 	}
-	return nil
+	return 0
 }
 
-func (x *NetflowReportEnt) GetTopCountryList() []*NetflowKeyCountEnt {
+func (x *LogFieldStatsEnt) GetMax() float64 {
 	if x != nil {
-		return x.TopCountryList
+		return x.Max
 	}
-	return nil
+	return 0
 }
 
-type WindowsEventSummary struct {
+type LogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Computer      string                 `protobuf:"bytes,1,opt,name=computer,proto3" json:"computer,omitempty"`
-	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Src           string                 `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Log           string                 `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WindowsEventSummary) Reset() {
-	*x = WindowsEventSummary{}
+func (x *LogResponse) Reset() {
+	*x = LogResponse{}
+	mi := &file_twlogeye_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogResponse) ProtoMessage() {}
+
+func (x *LogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogResponse.ProtoReflect.Descriptor instead.
+func (*LogResponse) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LogResponse) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LogResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *LogResponse) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+type ControlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlResponse) Reset() {
+	*x = ControlResponse{}
 	mi := &file_twlogeye_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WindowsEventSummary) String() string {
+func (x *ControlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowsEventSummary) ProtoMessage() {}
+func (*ControlResponse) ProtoMessage() {}
 
-func (x *WindowsEventSummary) ProtoReflect() protoreflect.Message {
+func (x *ControlResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_twlogeye_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1168,68 +1558,2039 @@ func (x *WindowsEventSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowsEventSummary.ProtoReflect.Descriptor instead.
-func (*WindowsEventSummary) Descriptor() ([]byte, []int) {
+// Deprecated: Use ControlResponse.ProtoReflect.Descriptor instead.
+func (*ControlResponse) Descriptor() ([]byte, []int) {
 	return file_twlogeye_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WindowsEventSummary) GetComputer() string {
+func (x *ControlResponse) GetOk() bool {
 	if x != nil {
-		return x.Computer
+		return x.Ok
 	}
-	return ""
+	return false
 }
 
-func (x *WindowsEventSummary) GetProvider() string {
+func (x *ControlResponse) GetMessage() string {
 	if x != nil {
-		return x.Provider
+		return x.Message
 	}
 	return ""
 }
 
-func (x *WindowsEventSummary) GetEventId() string {
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_twlogeye_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[17]
 	if x != nil {
-		return x.EventId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{17}
+}
+
+type IDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IDRequest) Reset() {
+	*x = IDRequest{}
+	mi := &file_twlogeye_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDRequest) ProtoMessage() {}
+
+func (x *IDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDRequest.ProtoReflect.Descriptor instead.
+func (*IDRequest) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IDRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WindowsEventSummary) GetCount() int32 {
+type ReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	mi := &file_twlogeye_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[19]
 	if x != nil {
-		return x.Count
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReportRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
 	}
 	return 0
 }
 
-type WindowsEventReportEnt struct {
+func (x *ReportRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type LogSummaryEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Normal        int32                  `protobuf:"varint,2,opt,name=normal,proto3" json:"normal,omitempty"`
-	Warn          int32                  `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error         int32                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
-	Types         int32                  `protobuf:"varint,5,opt,name=types,proto3" json:"types,omitempty"`
-	ErrorTypes    int32                  `protobuf:"varint,6,opt,name=error_types,json=errorTypes,proto3" json:"error_types,omitempty"`
-	TopList       []*WindowsEventSummary `protobuf:"bytes,7,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
-	TopErrorList  []*WindowsEventSummary `protobuf:"bytes,8,rep,name=top_error_list,json=topErrorList,proto3" json:"top_error_list,omitempty"`
+	LogPattern    string                 `protobuf:"bytes,1,opt,name=log_pattern,json=logPattern,proto3" json:"log_pattern,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogSummaryEnt) Reset() {
+	*x = LogSummaryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogSummaryEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSummaryEnt) ProtoMessage() {}
+
+func (x *LogSummaryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSummaryEnt.ProtoReflect.Descriptor instead.
+func (*LogSummaryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LogSummaryEnt) GetLogPattern() string {
+	if x != nil {
+		return x.LogPattern
+	}
+	return ""
+}
+
+func (x *LogSummaryEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SyslogReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Normal        int32                  `protobuf:"varint,2,opt,name=normal,proto3" json:"normal,omitempty"`
+	Warn          int32                  `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error         int32                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+	Patterns      int32                  `protobuf:"varint,5,opt,name=patterns,proto3" json:"patterns,omitempty"`
+	ErrPatterns   int32                  `protobuf:"varint,6,opt,name=err_patterns,json=errPatterns,proto3" json:"err_patterns,omitempty"`
+	TopList       []*LogSummaryEnt       `protobuf:"bytes,7,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
+	TopErrorList  []*LogSummaryEnt       `protobuf:"bytes,8,rep,name=top_error_list,json=topErrorList,proto3" json:"top_error_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyslogReportEnt) Reset() {
+	*x = SyslogReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyslogReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyslogReportEnt) ProtoMessage() {}
+
+func (x *SyslogReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyslogReportEnt.ProtoReflect.Descriptor instead.
+func (*SyslogReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SyslogReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *SyslogReportEnt) GetNormal() int32 {
+	if x != nil {
+		return x.Normal
+	}
+	return 0
+}
+
+func (x *SyslogReportEnt) GetWarn() int32 {
+	if x != nil {
+		return x.Warn
+	}
+	return 0
+}
+
+func (x *SyslogReportEnt) GetError() int32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *SyslogReportEnt) GetPatterns() int32 {
+	if x != nil {
+		return x.Patterns
+	}
+	return 0
+}
+
+func (x *SyslogReportEnt) GetErrPatterns() int32 {
+	if x != nil {
+		return x.ErrPatterns
+	}
+	return 0
+}
+
+func (x *SyslogReportEnt) GetTopList() []*LogSummaryEnt {
+	if x != nil {
+		return x.TopList
+	}
+	return nil
+}
+
+func (x *SyslogReportEnt) GetTopErrorList() []*LogSummaryEnt {
+	if x != nil {
+		return x.TopErrorList
+	}
+	return nil
+}
+
+type TrapSummaryEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sender        string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TrapType      string                 `protobuf:"bytes,2,opt,name=trap_type,json=trapType,proto3" json:"trap_type,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrapSummaryEnt) Reset() {
+	*x = TrapSummaryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrapSummaryEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrapSummaryEnt) ProtoMessage() {}
+
+func (x *TrapSummaryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrapSummaryEnt.ProtoReflect.Descriptor instead.
+func (*TrapSummaryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TrapSummaryEnt) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *TrapSummaryEnt) GetTrapType() string {
+	if x != nil {
+		return x.TrapType
+	}
+	return ""
+}
+
+func (x *TrapSummaryEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TrapReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Types         int32                  `protobuf:"varint,3,opt,name=types,proto3" json:"types,omitempty"`
+	TopList       []*TrapSummaryEnt      `protobuf:"bytes,4,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrapReportEnt) Reset() {
+	*x = TrapReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrapReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrapReportEnt) ProtoMessage() {}
+
+func (x *TrapReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrapReportEnt.ProtoReflect.Descriptor instead.
+func (*TrapReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TrapReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *TrapReportEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TrapReportEnt) GetTypes() int32 {
+	if x != nil {
+		return x.Types
+	}
+	return 0
+}
+
+func (x *TrapReportEnt) GetTopList() []*TrapSummaryEnt {
+	if x != nil {
+		return x.TopList
+	}
+	return nil
+}
+
+type NetflowPacketsSummaryEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Packets       int32                  `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowPacketsSummaryEnt) Reset() {
+	*x = NetflowPacketsSummaryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowPacketsSummaryEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowPacketsSummaryEnt) ProtoMessage() {}
+
+func (x *NetflowPacketsSummaryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowPacketsSummaryEnt.ProtoReflect.Descriptor instead.
+func (*NetflowPacketsSummaryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NetflowPacketsSummaryEnt) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NetflowPacketsSummaryEnt) GetPackets() int32 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+type NetflowBytesSummaryEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Bytes         int64                  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowBytesSummaryEnt) Reset() {
+	*x = NetflowBytesSummaryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowBytesSummaryEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowBytesSummaryEnt) ProtoMessage() {}
+
+func (x *NetflowBytesSummaryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowBytesSummaryEnt.ProtoReflect.Descriptor instead.
+func (*NetflowBytesSummaryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NetflowBytesSummaryEnt) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NetflowBytesSummaryEnt) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type NetflowKeyCountEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowKeyCountEnt) Reset() {
+	*x = NetflowKeyCountEnt{}
+	mi := &file_twlogeye_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowKeyCountEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowKeyCountEnt) ProtoMessage() {}
+
+func (x *NetflowKeyCountEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowKeyCountEnt.ProtoReflect.Descriptor instead.
+func (*NetflowKeyCountEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NetflowKeyCountEnt) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NetflowKeyCountEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NetflowScanEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Src           string                 `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           string                 `protobuf:"bytes,4,opt,name=dst,proto3" json:"dst,omitempty"`
+	PortRange     string                 `protobuf:"bytes,5,opt,name=port_range,json=portRange,proto3" json:"port_range,omitempty"`
+	Count         int32                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Rate          float64                `protobuf:"fixed64,7,opt,name=rate,proto3" json:"rate,omitempty"`
+	Targets       []string               `protobuf:"bytes,8,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowScanEnt) Reset() {
+	*x = NetflowScanEnt{}
+	mi := &file_twlogeye_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowScanEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowScanEnt) ProtoMessage() {}
+
+func (x *NetflowScanEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowScanEnt.ProtoReflect.Descriptor instead.
+func (*NetflowScanEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NetflowScanEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *NetflowScanEnt) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NetflowScanEnt) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *NetflowScanEnt) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *NetflowScanEnt) GetPortRange() string {
+	if x != nil {
+		return x.PortRange
+	}
+	return ""
+}
+
+func (x *NetflowScanEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NetflowScanEnt) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *NetflowScanEnt) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type NetflowBeaconEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Src           string                 `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           string                 `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Interval      float64                `protobuf:"fixed64,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Jitter        float64                `protobuf:"fixed64,7,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	Score         float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowBeaconEnt) Reset() {
+	*x = NetflowBeaconEnt{}
+	mi := &file_twlogeye_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowBeaconEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowBeaconEnt) ProtoMessage() {}
+
+func (x *NetflowBeaconEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowBeaconEnt.ProtoReflect.Descriptor instead.
+func (*NetflowBeaconEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NetflowBeaconEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *NetflowBeaconEnt) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *NetflowBeaconEnt) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *NetflowBeaconEnt) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *NetflowBeaconEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NetflowBeaconEnt) GetInterval() float64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *NetflowBeaconEnt) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *NetflowBeaconEnt) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type NetflowZoneEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           string                 `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           string                 `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	Packets       int64                  `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes         int64                  `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Flows         int32                  `protobuf:"varint,5,opt,name=flows,proto3" json:"flows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowZoneEnt) Reset() {
+	*x = NetflowZoneEnt{}
+	mi := &file_twlogeye_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowZoneEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowZoneEnt) ProtoMessage() {}
+
+func (x *NetflowZoneEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowZoneEnt.ProtoReflect.Descriptor instead.
+func (*NetflowZoneEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NetflowZoneEnt) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *NetflowZoneEnt) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *NetflowZoneEnt) GetPackets() int64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *NetflowZoneEnt) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *NetflowZoneEnt) GetFlows() int32 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+type NetflowIfEnt struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Exporter      string                    `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter,omitempty"`
+	IfIndex       int32                     `protobuf:"varint,2,opt,name=if_index,json=ifIndex,proto3" json:"if_index,omitempty"`
+	Name          string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	InBytes       int64                     `protobuf:"varint,4,opt,name=in_bytes,json=inBytes,proto3" json:"in_bytes,omitempty"`
+	OutBytes      int64                     `protobuf:"varint,5,opt,name=out_bytes,json=outBytes,proto3" json:"out_bytes,omitempty"`
+	InPackets     int64                     `protobuf:"varint,6,opt,name=in_packets,json=inPackets,proto3" json:"in_packets,omitempty"`
+	OutPackets    int64                     `protobuf:"varint,7,opt,name=out_packets,json=outPackets,proto3" json:"out_packets,omitempty"`
+	InBps         float64                   `protobuf:"fixed64,8,opt,name=in_bps,json=inBps,proto3" json:"in_bps,omitempty"`
+	OutBps        float64                   `protobuf:"fixed64,9,opt,name=out_bps,json=outBps,proto3" json:"out_bps,omitempty"`
+	InPps         float64                   `protobuf:"fixed64,10,opt,name=in_pps,json=inPps,proto3" json:"in_pps,omitempty"`
+	OutPps        float64                   `protobuf:"fixed64,11,opt,name=out_pps,json=outPps,proto3" json:"out_pps,omitempty"`
+	InUtil        float64                   `protobuf:"fixed64,12,opt,name=in_util,json=inUtil,proto3" json:"in_util,omitempty"`
+	OutUtil       float64                   `protobuf:"fixed64,13,opt,name=out_util,json=outUtil,proto3" json:"out_util,omitempty"`
+	TopTalkerList []*NetflowBytesSummaryEnt `protobuf:"bytes,14,rep,name=top_talker_list,json=topTalkerList,proto3" json:"top_talker_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetflowIfEnt) Reset() {
+	*x = NetflowIfEnt{}
+	mi := &file_twlogeye_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowIfEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowIfEnt) ProtoMessage() {}
+
+func (x *NetflowIfEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowIfEnt.ProtoReflect.Descriptor instead.
+func (*NetflowIfEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NetflowIfEnt) GetExporter() string {
+	if x != nil {
+		return x.Exporter
+	}
+	return ""
+}
+
+func (x *NetflowIfEnt) GetIfIndex() int32 {
+	if x != nil {
+		return x.IfIndex
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetflowIfEnt) GetInBytes() int64 {
+	if x != nil {
+		return x.InBytes
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetOutBytes() int64 {
+	if x != nil {
+		return x.OutBytes
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetInPackets() int64 {
+	if x != nil {
+		return x.InPackets
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetOutPackets() int64 {
+	if x != nil {
+		return x.OutPackets
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetInBps() float64 {
+	if x != nil {
+		return x.InBps
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetOutBps() float64 {
+	if x != nil {
+		return x.OutBps
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetInPps() float64 {
+	if x != nil {
+		return x.InPps
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetOutPps() float64 {
+	if x != nil {
+		return x.OutPps
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetInUtil() float64 {
+	if x != nil {
+		return x.InUtil
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetOutUtil() float64 {
+	if x != nil {
+		return x.OutUtil
+	}
+	return 0
+}
+
+func (x *NetflowIfEnt) GetTopTalkerList() []*NetflowBytesSummaryEnt {
+	if x != nil {
+		return x.TopTalkerList
+	}
+	return nil
+}
+
+type NetflowReportEnt struct {
+	state              protoimpl.MessageState      `protogen:"open.v1"`
+	Time               int64                       `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Packets            int64                       `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes              int64                       `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Macs               int32                       `protobuf:"varint,4,opt,name=macs,proto3" json:"macs,omitempty"`
+	Ips                int32                       `protobuf:"varint,5,opt,name=ips,proto3" json:"ips,omitempty"`
+	Flows              int32                       `protobuf:"varint,6,opt,name=flows,proto3" json:"flows,omitempty"`
+	Protocols          int32                       `protobuf:"varint,7,opt,name=protocols,proto3" json:"protocols,omitempty"`
+	Fumbles            int32                       `protobuf:"varint,8,opt,name=fumbles,proto3" json:"fumbles,omitempty"`
+	Hosts              int32                       `protobuf:"varint,9,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	Locs               int32                       `protobuf:"varint,10,opt,name=locs,proto3" json:"locs,omitempty"`
+	Country            int32                       `protobuf:"varint,11,opt,name=country,proto3" json:"country,omitempty"`
+	TopMacPacketsList  []*NetflowPacketsSummaryEnt `protobuf:"bytes,12,rep,name=top_mac_packets_list,json=topMacPacketsList,proto3" json:"top_mac_packets_list,omitempty"`
+	TopMacBytesList    []*NetflowBytesSummaryEnt   `protobuf:"bytes,13,rep,name=top_mac_bytes_list,json=topMacBytesList,proto3" json:"top_mac_bytes_list,omitempty"`
+	TopIpPacketsList   []*NetflowPacketsSummaryEnt `protobuf:"bytes,14,rep,name=top_ip_packets_list,json=topIpPacketsList,proto3" json:"top_ip_packets_list,omitempty"`
+	TopIpBytesList     []*NetflowBytesSummaryEnt   `protobuf:"bytes,15,rep,name=top_ip_bytes_list,json=topIpBytesList,proto3" json:"top_ip_bytes_list,omitempty"`
+	TopFlowPacketsList []*NetflowPacketsSummaryEnt `protobuf:"bytes,16,rep,name=top_flow_packets_list,json=topFlowPacketsList,proto3" json:"top_flow_packets_list,omitempty"`
+	TopFlowBytesList   []*NetflowBytesSummaryEnt   `protobuf:"bytes,17,rep,name=top_flow_bytes_list,json=topFlowBytesList,proto3" json:"top_flow_bytes_list,omitempty"`
+	TopProtocolList    []*NetflowKeyCountEnt       `protobuf:"bytes,18,rep,name=top_protocol_list,json=topProtocolList,proto3" json:"top_protocol_list,omitempty"`
+	TopFumbleSrcList   []*NetflowKeyCountEnt       `protobuf:"bytes,19,rep,name=top_fumble_src_list,json=topFumbleSrcList,proto3" json:"top_fumble_src_list,omitempty"`
+	TopHostList        []*NetflowKeyCountEnt       `protobuf:"bytes,20,rep,name=top_host_list,json=topHostList,proto3" json:"top_host_list,omitempty"`
+	TopLocList         []*NetflowKeyCountEnt       `protobuf:"bytes,21,rep,name=top_loc_list,json=topLocList,proto3" json:"top_loc_list,omitempty"`
+	TopCountryList     []*NetflowKeyCountEnt       `protobuf:"bytes,22,rep,name=top_country_list,json=topCountryList,proto3" json:"top_country_list,omitempty"`
+	Scans              int32                       `protobuf:"varint,23,opt,name=scans,proto3" json:"scans,omitempty"`
+	ScanList           []*NetflowScanEnt           `protobuf:"bytes,24,rep,name=scan_list,json=scanList,proto3" json:"scan_list,omitempty"`
+	Beacons            int32                       `protobuf:"varint,25,opt,name=beacons,proto3" json:"beacons,omitempty"`
+	BeaconList         []*NetflowBeaconEnt         `protobuf:"bytes,26,rep,name=beacon_list,json=beaconList,proto3" json:"beacon_list,omitempty"`
+	ZoneMatrix         []*NetflowZoneEnt           `protobuf:"bytes,27,rep,name=zone_matrix,json=zoneMatrix,proto3" json:"zone_matrix,omitempty"`
+	IfList             []*NetflowIfEnt             `protobuf:"bytes,28,rep,name=if_list,json=ifList,proto3" json:"if_list,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NetflowReportEnt) Reset() {
+	*x = NetflowReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetflowReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetflowReportEnt) ProtoMessage() {}
+
+func (x *NetflowReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetflowReportEnt.ProtoReflect.Descriptor instead.
+func (*NetflowReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NetflowReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetPackets() int64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetMacs() int32 {
+	if x != nil {
+		return x.Macs
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetIps() int32 {
+	if x != nil {
+		return x.Ips
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetFlows() int32 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetProtocols() int32 {
+	if x != nil {
+		return x.Protocols
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetFumbles() int32 {
+	if x != nil {
+		return x.Fumbles
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetHosts() int32 {
+	if x != nil {
+		return x.Hosts
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetLocs() int32 {
+	if x != nil {
+		return x.Locs
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetCountry() int32 {
+	if x != nil {
+		return x.Country
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetTopMacPacketsList() []*NetflowPacketsSummaryEnt {
+	if x != nil {
+		return x.TopMacPacketsList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopMacBytesList() []*NetflowBytesSummaryEnt {
+	if x != nil {
+		return x.TopMacBytesList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopIpPacketsList() []*NetflowPacketsSummaryEnt {
+	if x != nil {
+		return x.TopIpPacketsList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopIpBytesList() []*NetflowBytesSummaryEnt {
+	if x != nil {
+		return x.TopIpBytesList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopFlowPacketsList() []*NetflowPacketsSummaryEnt {
+	if x != nil {
+		return x.TopFlowPacketsList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopFlowBytesList() []*NetflowBytesSummaryEnt {
+	if x != nil {
+		return x.TopFlowBytesList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopProtocolList() []*NetflowKeyCountEnt {
+	if x != nil {
+		return x.TopProtocolList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopFumbleSrcList() []*NetflowKeyCountEnt {
+	if x != nil {
+		return x.TopFumbleSrcList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopHostList() []*NetflowKeyCountEnt {
+	if x != nil {
+		return x.TopHostList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopLocList() []*NetflowKeyCountEnt {
+	if x != nil {
+		return x.TopLocList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetTopCountryList() []*NetflowKeyCountEnt {
+	if x != nil {
+		return x.TopCountryList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetScans() int32 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetScanList() []*NetflowScanEnt {
+	if x != nil {
+		return x.ScanList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetBeacons() int32 {
+	if x != nil {
+		return x.Beacons
+	}
+	return 0
+}
+
+func (x *NetflowReportEnt) GetBeaconList() []*NetflowBeaconEnt {
+	if x != nil {
+		return x.BeaconList
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetZoneMatrix() []*NetflowZoneEnt {
+	if x != nil {
+		return x.ZoneMatrix
+	}
+	return nil
+}
+
+func (x *NetflowReportEnt) GetIfList() []*NetflowIfEnt {
+	if x != nil {
+		return x.IfList
+	}
+	return nil
+}
+
+type WindowsEventSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Computer      string                 `protobuf:"bytes,1,opt,name=computer,proto3" json:"computer,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowsEventSummary) Reset() {
+	*x = WindowsEventSummary{}
+	mi := &file_twlogeye_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowsEventSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsEventSummary) ProtoMessage() {}
+
+func (x *WindowsEventSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsEventSummary.ProtoReflect.Descriptor instead.
+func (*WindowsEventSummary) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WindowsEventSummary) GetComputer() string {
+	if x != nil {
+		return x.Computer
+	}
+	return ""
+}
+
+func (x *WindowsEventSummary) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WindowsEventSummary) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WindowsEventSummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type WindowsEventReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Normal        int32                  `protobuf:"varint,2,opt,name=normal,proto3" json:"normal,omitempty"`
+	Warn          int32                  `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error         int32                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+	Types         int32                  `protobuf:"varint,5,opt,name=types,proto3" json:"types,omitempty"`
+	ErrorTypes    int32                  `protobuf:"varint,6,opt,name=error_types,json=errorTypes,proto3" json:"error_types,omitempty"`
+	TopList       []*WindowsEventSummary `protobuf:"bytes,7,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
+	TopErrorList  []*WindowsEventSummary `protobuf:"bytes,8,rep,name=top_error_list,json=topErrorList,proto3" json:"top_error_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowsEventReportEnt) Reset() {
+	*x = WindowsEventReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowsEventReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsEventReportEnt) ProtoMessage() {}
+
+func (x *WindowsEventReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsEventReportEnt.ProtoReflect.Descriptor instead.
+func (*WindowsEventReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WindowsEventReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *WindowsEventReportEnt) GetNormal() int32 {
+	if x != nil {
+		return x.Normal
+	}
+	return 0
+}
+
+func (x *WindowsEventReportEnt) GetWarn() int32 {
+	if x != nil {
+		return x.Warn
+	}
+	return 0
+}
+
+func (x *WindowsEventReportEnt) GetError() int32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *WindowsEventReportEnt) GetTypes() int32 {
+	if x != nil {
+		return x.Types
+	}
+	return 0
+}
+
+func (x *WindowsEventReportEnt) GetErrorTypes() int32 {
+	if x != nil {
+		return x.ErrorTypes
+	}
+	return 0
+}
+
+func (x *WindowsEventReportEnt) GetTopList() []*WindowsEventSummary {
+	if x != nil {
+		return x.TopList
+	}
+	return nil
+}
+
+func (x *WindowsEventReportEnt) GetTopErrorList() []*WindowsEventSummary {
+	if x != nil {
+		return x.TopErrorList
+	}
+	return nil
+}
+
+type OTelSummaryEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTelSummaryEnt) Reset() {
+	*x = OTelSummaryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTelSummaryEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTelSummaryEnt) ProtoMessage() {}
+
+func (x *OTelSummaryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTelSummaryEnt.ProtoReflect.Descriptor instead.
+func (*OTelSummaryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OTelSummaryEnt) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *OTelSummaryEnt) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *OTelSummaryEnt) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *OTelSummaryEnt) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *OTelSummaryEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type OTelReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Normal        int32                  `protobuf:"varint,2,opt,name=normal,proto3" json:"normal,omitempty"`
+	Warn          int32                  `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error         int32                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+	Types         int32                  `protobuf:"varint,5,opt,name=types,proto3" json:"types,omitempty"`
+	ErrorTypes    int32                  `protobuf:"varint,6,opt,name=error_types,json=errorTypes,proto3" json:"error_types,omitempty"`
+	TopList       []*OTelSummaryEnt      `protobuf:"bytes,7,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
+	TopErrorList  []*OTelSummaryEnt      `protobuf:"bytes,8,rep,name=top_error_list,json=topErrorList,proto3" json:"top_error_list,omitempty"`
+	Hosts         int32                  `protobuf:"varint,9,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	TraceIds      int32                  `protobuf:"varint,10,opt,name=trace_ids,json=traceIds,proto3" json:"trace_ids,omitempty"`
+	TraceCount    int32                  `protobuf:"varint,11,opt,name=trace_count,json=traceCount,proto3" json:"trace_count,omitempty"`
+	MericsCount   int32                  `protobuf:"varint,12,opt,name=merics_count,json=mericsCount,proto3" json:"merics_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTelReportEnt) Reset() {
+	*x = OTelReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTelReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTelReportEnt) ProtoMessage() {}
+
+func (x *OTelReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTelReportEnt.ProtoReflect.Descriptor instead.
+func (*OTelReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OTelReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetNormal() int32 {
+	if x != nil {
+		return x.Normal
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetWarn() int32 {
+	if x != nil {
+		return x.Warn
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetError() int32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetTypes() int32 {
+	if x != nil {
+		return x.Types
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetErrorTypes() int32 {
+	if x != nil {
+		return x.ErrorTypes
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetTopList() []*OTelSummaryEnt {
+	if x != nil {
+		return x.TopList
+	}
+	return nil
+}
+
+func (x *OTelReportEnt) GetTopErrorList() []*OTelSummaryEnt {
+	if x != nil {
+		return x.TopErrorList
+	}
+	return nil
+}
+
+func (x *OTelReportEnt) GetHosts() int32 {
+	if x != nil {
+		return x.Hosts
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetTraceIds() int32 {
+	if x != nil {
+		return x.TraceIds
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetTraceCount() int32 {
+	if x != nil {
+		return x.TraceCount
+	}
+	return 0
+}
+
+func (x *OTelReportEnt) GetMericsCount() int32 {
+	if x != nil {
+		return x.MericsCount
+	}
+	return 0
+}
+
+type MqttSummaryEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MqttSummaryEnt) Reset() {
+	*x = MqttSummaryEnt{}
+	mi := &file_twlogeye_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MqttSummaryEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MqttSummaryEnt) ProtoMessage() {}
+
+func (x *MqttSummaryEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MqttSummaryEnt.ProtoReflect.Descriptor instead.
+func (*MqttSummaryEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MqttSummaryEnt) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *MqttSummaryEnt) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *MqttSummaryEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MqttReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Types         int32                  `protobuf:"varint,3,opt,name=types,proto3" json:"types,omitempty"`
+	TopList       []*MqttSummaryEnt      `protobuf:"bytes,4,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MqttReportEnt) Reset() {
+	*x = MqttReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MqttReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MqttReportEnt) ProtoMessage() {}
+
+func (x *MqttReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MqttReportEnt.ProtoReflect.Descriptor instead.
+func (*MqttReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MqttReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *MqttReportEnt) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MqttReportEnt) GetTypes() int32 {
+	if x != nil {
+		return x.Types
+	}
+	return 0
+}
+
+func (x *MqttReportEnt) GetTopList() []*MqttSummaryEnt {
+	if x != nil {
+		return x.TopList
+	}
+	return nil
+}
+
+type AnomalyReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyReportRequest) Reset() {
+	*x = AnomalyReportRequest{}
+	mi := &file_twlogeye_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyReportRequest) ProtoMessage() {}
+
+func (x *AnomalyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyReportRequest.ProtoReflect.Descriptor instead.
+func (*AnomalyReportRequest) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AnomalyReportRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *AnomalyReportRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *AnomalyReportRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type AnomalyReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyReportEnt) Reset() {
+	*x = AnomalyReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyReportEnt) ProtoMessage() {}
+
+func (x *AnomalyReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyReportEnt.ProtoReflect.Descriptor instead.
+func (*AnomalyReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AnomalyReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AnomalyReportEnt) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type LastAnomalyReportScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Time          int64                  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LastAnomalyReportScore) Reset() {
+	*x = LastAnomalyReportScore{}
+	mi := &file_twlogeye_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LastAnomalyReportScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastAnomalyReportScore) ProtoMessage() {}
+
+func (x *LastAnomalyReportScore) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastAnomalyReportScore.ProtoReflect.Descriptor instead.
+func (*LastAnomalyReportScore) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LastAnomalyReportScore) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LastAnomalyReportScore) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LastAnomalyReportScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type LastAnomalyReportEnt struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Time          int64                     `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	ScoreList     []*LastAnomalyReportScore `protobuf:"bytes,2,rep,name=score_list,json=scoreList,proto3" json:"score_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LastAnomalyReportEnt) Reset() {
+	*x = LastAnomalyReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LastAnomalyReportEnt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastAnomalyReportEnt) ProtoMessage() {}
+
+func (x *LastAnomalyReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastAnomalyReportEnt.ProtoReflect.Descriptor instead.
+func (*LastAnomalyReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LastAnomalyReportEnt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LastAnomalyReportEnt) GetScoreList() []*LastAnomalyReportScore {
+	if x != nil {
+		return x.ScoreList
+	}
+	return nil
+}
+
+type MonitorReportEnt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Cpu           float64                `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        float64                `protobuf:"fixed64,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Load          float64                `protobuf:"fixed64,4,opt,name=load,proto3" json:"load,omitempty"`
+	Disk          float64                `protobuf:"fixed64,5,opt,name=disk,proto3" json:"disk,omitempty"`
+	Net           float64                `protobuf:"fixed64,6,opt,name=net,proto3" json:"net,omitempty"`
+	Bytes         int64                  `protobuf:"varint,7,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	DbSpeed       float64                `protobuf:"fixed64,8,opt,name=db_speed,json=dbSpeed,proto3" json:"db_speed,omitempty"`
+	DbSize        int64                  `protobuf:"varint,9,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
+	Usage         []*DBUsageEnt          `protobuf:"bytes,10,rep,name=usage,proto3" json:"usage,omitempty"`
+	LsmSize       int64                  `protobuf:"varint,11,opt,name=lsm_size,json=lsmSize,proto3" json:"lsm_size,omitempty"`
+	VlogSize      int64                  `protobuf:"varint,12,opt,name=vlog_size,json=vlogSize,proto3" json:"vlog_size,omitempty"`
+	LastGc        *DBMaintenanceEnt      `protobuf:"bytes,13,opt,name=last_gc,json=lastGc,proto3" json:"last_gc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WindowsEventReportEnt) Reset() {
-	*x = WindowsEventReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[17]
+func (x *MonitorReportEnt) Reset() {
+	*x = MonitorReportEnt{}
+	mi := &file_twlogeye_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WindowsEventReportEnt) String() string {
+func (x *MonitorReportEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowsEventReportEnt) ProtoMessage() {}
+func (*MonitorReportEnt) ProtoMessage() {}
 
-func (x *WindowsEventReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[17]
+func (x *MonitorReportEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,93 +3601,130 @@ func (x *WindowsEventReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowsEventReportEnt.ProtoReflect.Descriptor instead.
-func (*WindowsEventReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MonitorReportEnt.ProtoReflect.Descriptor instead.
+func (*MonitorReportEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *WindowsEventReportEnt) GetTime() int64 {
+func (x *MonitorReportEnt) GetTime() int64 {
 	if x != nil {
 		return x.Time
 	}
 	return 0
 }
 
-func (x *WindowsEventReportEnt) GetNormal() int32 {
+func (x *MonitorReportEnt) GetCpu() float64 {
 	if x != nil {
-		return x.Normal
+		return x.Cpu
 	}
 	return 0
 }
 
-func (x *WindowsEventReportEnt) GetWarn() int32 {
+func (x *MonitorReportEnt) GetMemory() float64 {
 	if x != nil {
-		return x.Warn
+		return x.Memory
 	}
 	return 0
 }
 
-func (x *WindowsEventReportEnt) GetError() int32 {
+func (x *MonitorReportEnt) GetLoad() float64 {
 	if x != nil {
-		return x.Error
+		return x.Load
 	}
 	return 0
 }
 
-func (x *WindowsEventReportEnt) GetTypes() int32 {
+func (x *MonitorReportEnt) GetDisk() float64 {
 	if x != nil {
-		return x.Types
+		return x.Disk
 	}
 	return 0
 }
 
-func (x *WindowsEventReportEnt) GetErrorTypes() int32 {
+func (x *MonitorReportEnt) GetNet() float64 {
 	if x != nil {
-		return x.ErrorTypes
+		return x.Net
 	}
 	return 0
 }
 
-func (x *WindowsEventReportEnt) GetTopList() []*WindowsEventSummary {
+func (x *MonitorReportEnt) GetBytes() int64 {
 	if x != nil {
-		return x.TopList
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *MonitorReportEnt) GetDbSpeed() float64 {
+	if x != nil {
+		return x.DbSpeed
+	}
+	return 0
+}
+
+func (x *MonitorReportEnt) GetDbSize() int64 {
+	if x != nil {
+		return x.DbSize
+	}
+	return 0
+}
+
+func (x *MonitorReportEnt) GetUsage() []*DBUsageEnt {
+	if x != nil {
+		return x.Usage
 	}
 	return nil
 }
 
-func (x *WindowsEventReportEnt) GetTopErrorList() []*WindowsEventSummary {
+func (x *MonitorReportEnt) GetLsmSize() int64 {
 	if x != nil {
-		return x.TopErrorList
+		return x.LsmSize
+	}
+	return 0
+}
+
+func (x *MonitorReportEnt) GetVlogSize() int64 {
+	if x != nil {
+		return x.VlogSize
+	}
+	return 0
+}
+
+func (x *MonitorReportEnt) GetLastGc() *DBMaintenanceEnt {
+	if x != nil {
+		return x.LastGc
 	}
 	return nil
 }
 
-type OTelSummaryEnt struct {
+type DBMaintenanceEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
-	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
-	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Duration      int64                  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Flatten       bool                   `protobuf:"varint,3,opt,name=flatten,proto3" json:"flatten,omitempty"`
+	GcCount       int64                  `protobuf:"varint,4,opt,name=gc_count,json=gcCount,proto3" json:"gc_count,omitempty"`
+	Before        int64                  `protobuf:"varint,5,opt,name=before,proto3" json:"before,omitempty"`
+	After         int64                  `protobuf:"varint,6,opt,name=after,proto3" json:"after,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTelSummaryEnt) Reset() {
-	*x = OTelSummaryEnt{}
-	mi := &file_twlogeye_proto_msgTypes[18]
+func (x *DBMaintenanceEnt) Reset() {
+	*x = DBMaintenanceEnt{}
+	mi := &file_twlogeye_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTelSummaryEnt) String() string {
+func (x *DBMaintenanceEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTelSummaryEnt) ProtoMessage() {}
+func (*DBMaintenanceEnt) ProtoMessage() {}
 
-func (x *OTelSummaryEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[18]
+func (x *DBMaintenanceEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,79 +3735,88 @@ func (x *OTelSummaryEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OTelSummaryEnt.ProtoReflect.Descriptor instead.
-func (*OTelSummaryEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DBMaintenanceEnt.ProtoReflect.Descriptor instead.
+func (*DBMaintenanceEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *OTelSummaryEnt) GetHost() string {
+func (x *DBMaintenanceEnt) GetTime() int64 {
 	if x != nil {
-		return x.Host
+		return x.Time
 	}
-	return ""
+	return 0
 }
 
-func (x *OTelSummaryEnt) GetService() string {
+func (x *DBMaintenanceEnt) GetDuration() int64 {
 	if x != nil {
-		return x.Service
+		return x.Duration
 	}
-	return ""
+	return 0
 }
 
-func (x *OTelSummaryEnt) GetScope() string {
+func (x *DBMaintenanceEnt) GetFlatten() bool {
 	if x != nil {
-		return x.Scope
+		return x.Flatten
 	}
-	return ""
+	return false
 }
 
-func (x *OTelSummaryEnt) GetSeverity() string {
+func (x *DBMaintenanceEnt) GetGcCount() int64 {
 	if x != nil {
-		return x.Severity
+		return x.GcCount
 	}
-	return ""
+	return 0
 }
 
-func (x *OTelSummaryEnt) GetCount() int32 {
+func (x *DBMaintenanceEnt) GetBefore() int64 {
 	if x != nil {
-		return x.Count
+		return x.Before
 	}
 	return 0
 }
 
-type OTelReportEnt struct {
+func (x *DBMaintenanceEnt) GetAfter() int64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+func (x *DBMaintenanceEnt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ArchiveEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Normal        int32                  `protobuf:"varint,2,opt,name=normal,proto3" json:"normal,omitempty"`
-	Warn          int32                  `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error         int32                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
-	Types         int32                  `protobuf:"varint,5,opt,name=types,proto3" json:"types,omitempty"`
-	ErrorTypes    int32                  `protobuf:"varint,6,opt,name=error_types,json=errorTypes,proto3" json:"error_types,omitempty"`
-	TopList       []*OTelSummaryEnt      `protobuf:"bytes,7,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
-	TopErrorList  []*OTelSummaryEnt      `protobuf:"bytes,8,rep,name=top_error_list,json=topErrorList,proto3" json:"top_error_list,omitempty"`
-	Hosts         int32                  `protobuf:"varint,9,opt,name=hosts,proto3" json:"hosts,omitempty"`
-	TraceIds      int32                  `protobuf:"varint,10,opt,name=trace_ids,json=traceIds,proto3" json:"trace_ids,omitempty"`
-	TraceCount    int32                  `protobuf:"varint,11,opt,name=trace_count,json=traceCount,proto3" json:"trace_count,omitempty"`
-	MericsCount   int32                  `protobuf:"varint,12,opt,name=merics_count,json=mericsCount,proto3" json:"merics_count,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int64                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	File          string                 `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Count         int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Proof         string                 `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTelReportEnt) Reset() {
-	*x = OTelReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[19]
+func (x *ArchiveEnt) Reset() {
+	*x = ArchiveEnt{}
+	mi := &file_twlogeye_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTelReportEnt) String() string {
+func (x *ArchiveEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTelReportEnt) ProtoMessage() {}
+func (*ArchiveEnt) ProtoMessage() {}
 
-func (x *OTelReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[19]
+func (x *ArchiveEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,119 +3827,89 @@ func (x *OTelReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OTelReportEnt.ProtoReflect.Descriptor instead.
-func (*OTelReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *OTelReportEnt) GetTime() int64 {
-	if x != nil {
-		return x.Time
-	}
-	return 0
-}
-
-func (x *OTelReportEnt) GetNormal() int32 {
-	if x != nil {
-		return x.Normal
-	}
-	return 0
-}
-
-func (x *OTelReportEnt) GetWarn() int32 {
-	if x != nil {
-		return x.Warn
-	}
-	return 0
+// Deprecated: Use ArchiveEnt.ProtoReflect.Descriptor instead.
+func (*ArchiveEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *OTelReportEnt) GetError() int32 {
+func (x *ArchiveEnt) GetType() string {
 	if x != nil {
-		return x.Error
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *OTelReportEnt) GetTypes() int32 {
+func (x *ArchiveEnt) GetStart() int64 {
 	if x != nil {
-		return x.Types
+		return x.Start
 	}
 	return 0
 }
 
-func (x *OTelReportEnt) GetErrorTypes() int32 {
+func (x *ArchiveEnt) GetEnd() int64 {
 	if x != nil {
-		return x.ErrorTypes
+		return x.End
 	}
 	return 0
 }
 
-func (x *OTelReportEnt) GetTopList() []*OTelSummaryEnt {
-	if x != nil {
-		return x.TopList
-	}
-	return nil
-}
-
-func (x *OTelReportEnt) GetTopErrorList() []*OTelSummaryEnt {
-	if x != nil {
-		return x.TopErrorList
-	}
-	return nil
-}
-
-func (x *OTelReportEnt) GetHosts() int32 {
+func (x *ArchiveEnt) GetFile() string {
 	if x != nil {
-		return x.Hosts
+		return x.File
 	}
-	return 0
+	return ""
 }
 
-func (x *OTelReportEnt) GetTraceIds() int32 {
+func (x *ArchiveEnt) GetCount() int64 {
 	if x != nil {
-		return x.TraceIds
+		return x.Count
 	}
 	return 0
 }
 
-func (x *OTelReportEnt) GetTraceCount() int32 {
+func (x *ArchiveEnt) GetSize() int64 {
 	if x != nil {
-		return x.TraceCount
+		return x.Size
 	}
 	return 0
 }
 
-func (x *OTelReportEnt) GetMericsCount() int32 {
+func (x *ArchiveEnt) GetProof() string {
 	if x != nil {
-		return x.MericsCount
+		return x.Proof
 	}
-	return 0
+	return ""
 }
 
-type MqttSummaryEnt struct {
+type LogVerifyEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Batches       int64                  `protobuf:"varint,2,opt,name=batches,proto3" json:"batches,omitempty"`
+	Logs          int64                  `protobuf:"varint,3,opt,name=logs,proto3" json:"logs,omitempty"`
+	Expired       int64                  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	Checkpoints   int64                  `protobuf:"varint,5,opt,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	FirstSeq      int64                  `protobuf:"varint,6,opt,name=first_seq,json=firstSeq,proto3" json:"first_seq,omitempty"`
+	LastSeq       int64                  `protobuf:"varint,7,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	Issues        []*LogVerifyIssue      `protobuf:"bytes,8,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MqttSummaryEnt) Reset() {
-	*x = MqttSummaryEnt{}
-	mi := &file_twlogeye_proto_msgTypes[20]
+func (x *LogVerifyEnt) Reset() {
+	*x = LogVerifyEnt{}
+	mi := &file_twlogeye_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MqttSummaryEnt) String() string {
+func (x *LogVerifyEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MqttSummaryEnt) ProtoMessage() {}
+func (*LogVerifyEnt) ProtoMessage() {}
 
-func (x *MqttSummaryEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[20]
+func (x *LogVerifyEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,124 +3920,92 @@ func (x *MqttSummaryEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MqttSummaryEnt.ProtoReflect.Descriptor instead.
-func (*MqttSummaryEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *MqttSummaryEnt) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
+// Deprecated: Use LogVerifyEnt.ProtoReflect.Descriptor instead.
+func (*LogVerifyEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *MqttSummaryEnt) GetTopic() string {
+func (x *LogVerifyEnt) GetType() string {
 	if x != nil {
-		return x.Topic
+		return x.Type
 	}
 	return ""
 }
 
-func (x *MqttSummaryEnt) GetCount() int32 {
+func (x *LogVerifyEnt) GetBatches() int64 {
 	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type MqttReportEnt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Types         int32                  `protobuf:"varint,3,opt,name=types,proto3" json:"types,omitempty"`
-	TopList       []*MqttSummaryEnt      `protobuf:"bytes,4,rep,name=top_list,json=topList,proto3" json:"top_list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MqttReportEnt) Reset() {
-	*x = MqttReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MqttReportEnt) String() string {
-	return protoimpl.X.MessageStringOf(x)
+		return x.Batches
+	}
+	return 0
 }
 
-func (*MqttReportEnt) ProtoMessage() {}
-
-func (x *MqttReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[21]
+func (x *LogVerifyEnt) GetLogs() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Logs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use MqttReportEnt.ProtoReflect.Descriptor instead.
-func (*MqttReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{21}
+func (x *LogVerifyEnt) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
 }
 
-func (x *MqttReportEnt) GetTime() int64 {
+func (x *LogVerifyEnt) GetCheckpoints() int64 {
 	if x != nil {
-		return x.Time
+		return x.Checkpoints
 	}
 	return 0
 }
 
-func (x *MqttReportEnt) GetCount() int32 {
+func (x *LogVerifyEnt) GetFirstSeq() int64 {
 	if x != nil {
-		return x.Count
+		return x.FirstSeq
 	}
 	return 0
 }
 
-func (x *MqttReportEnt) GetTypes() int32 {
+func (x *LogVerifyEnt) GetLastSeq() int64 {
 	if x != nil {
-		return x.Types
+		return x.LastSeq
 	}
 	return 0
 }
 
-func (x *MqttReportEnt) GetTopList() []*MqttSummaryEnt {
+func (x *LogVerifyEnt) GetIssues() []*LogVerifyIssue {
 	if x != nil {
-		return x.TopList
+		return x.Issues
 	}
 	return nil
 }
 
-type AnomalyReportRequest struct {
+type LogVerifyIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time          int64                  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnomalyReportRequest) Reset() {
-	*x = AnomalyReportRequest{}
-	mi := &file_twlogeye_proto_msgTypes[22]
+func (x *LogVerifyIssue) Reset() {
+	*x = LogVerifyIssue{}
+	mi := &file_twlogeye_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnomalyReportRequest) String() string {
+func (x *LogVerifyIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnomalyReportRequest) ProtoMessage() {}
+func (*LogVerifyIssue) ProtoMessage() {}
 
-func (x *AnomalyReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[22]
+func (x *LogVerifyIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,55 +4016,62 @@ func (x *AnomalyReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnomalyReportRequest.ProtoReflect.Descriptor instead.
-func (*AnomalyReportRequest) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use LogVerifyIssue.ProtoReflect.Descriptor instead.
+func (*LogVerifyIssue) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AnomalyReportRequest) GetStart() int64 {
+func (x *LogVerifyIssue) GetKind() string {
 	if x != nil {
-		return x.Start
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LogVerifyIssue) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
 	}
 	return 0
 }
 
-func (x *AnomalyReportRequest) GetEnd() int64 {
+func (x *LogVerifyIssue) GetTime() int64 {
 	if x != nil {
-		return x.End
+		return x.Time
 	}
 	return 0
 }
 
-func (x *AnomalyReportRequest) GetType() string {
+func (x *LogVerifyIssue) GetMessage() string {
 	if x != nil {
-		return x.Type
+		return x.Message
 	}
 	return ""
 }
 
-type AnomalyReportEnt struct {
+type BackupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Since         uint64                 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnomalyReportEnt) Reset() {
-	*x = AnomalyReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[23]
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	mi := &file_twlogeye_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnomalyReportEnt) String() string {
+func (x *BackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnomalyReportEnt) ProtoMessage() {}
+func (*BackupRequest) ProtoMessage() {}
 
-func (x *AnomalyReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[23]
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,49 +4082,48 @@ func (x *AnomalyReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnomalyReportEnt.ProtoReflect.Descriptor instead.
-func (*AnomalyReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *AnomalyReportEnt) GetTime() int64 {
+func (x *BackupRequest) GetSince() uint64 {
 	if x != nil {
-		return x.Time
+		return x.Since
 	}
 	return 0
 }
 
-func (x *AnomalyReportEnt) GetScore() float64 {
+func (x *BackupRequest) GetPath() string {
 	if x != nil {
-		return x.Score
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-type LastAnomalyReportScore struct {
+type BackupChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Time          int64                  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
-	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Manifest      string                 `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LastAnomalyReportScore) Reset() {
-	*x = LastAnomalyReportScore{}
-	mi := &file_twlogeye_proto_msgTypes[24]
+func (x *BackupChunk) Reset() {
+	*x = BackupChunk{}
+	mi := &file_twlogeye_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LastAnomalyReportScore) String() string {
+func (x *BackupChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastAnomalyReportScore) ProtoMessage() {}
+func (*BackupChunk) ProtoMessage() {}
 
-func (x *LastAnomalyReportScore) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[24]
+func (x *BackupChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,55 +4134,50 @@ func (x *LastAnomalyReportScore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastAnomalyReportScore.ProtoReflect.Descriptor instead.
-func (*LastAnomalyReportScore) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *LastAnomalyReportScore) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use BackupChunk.ProtoReflect.Descriptor instead.
+func (*BackupChunk) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *LastAnomalyReportScore) GetTime() int64 {
+func (x *BackupChunk) GetData() []byte {
 	if x != nil {
-		return x.Time
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *LastAnomalyReportScore) GetScore() float64 {
+func (x *BackupChunk) GetManifest() string {
 	if x != nil {
-		return x.Score
+		return x.Manifest
 	}
-	return 0
+	return ""
 }
 
-type LastAnomalyReportEnt struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Time          int64                     `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	ScoreList     []*LastAnomalyReportScore `protobuf:"bytes,2,rep,name=score_list,json=scoreList,proto3" json:"score_list,omitempty"`
+type DBStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LsmSize       int64                  `protobuf:"varint,1,opt,name=lsm_size,json=lsmSize,proto3" json:"lsm_size,omitempty"`
+	VlogSize      int64                  `protobuf:"varint,2,opt,name=vlog_size,json=vlogSize,proto3" json:"vlog_size,omitempty"`
+	Usage         []*DBUsageEnt          `protobuf:"bytes,3,rep,name=usage,proto3" json:"usage,omitempty"`
+	LastGc        *DBMaintenanceEnt      `protobuf:"bytes,4,opt,name=last_gc,json=lastGc,proto3" json:"last_gc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LastAnomalyReportEnt) Reset() {
-	*x = LastAnomalyReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[25]
+func (x *DBStatsResponse) Reset() {
+	*x = DBStatsResponse{}
+	mi := &file_twlogeye_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LastAnomalyReportEnt) String() string {
+func (x *DBStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LastAnomalyReportEnt) ProtoMessage() {}
+func (*DBStatsResponse) ProtoMessage() {}
 
-func (x *LastAnomalyReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[25]
+func (x *DBStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,55 +4188,65 @@ func (x *LastAnomalyReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LastAnomalyReportEnt.ProtoReflect.Descriptor instead.
-func (*LastAnomalyReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DBStatsResponse.ProtoReflect.Descriptor instead.
+func (*DBStatsResponse) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *LastAnomalyReportEnt) GetTime() int64 {
+func (x *DBStatsResponse) GetLsmSize() int64 {
 	if x != nil {
-		return x.Time
+		return x.LsmSize
 	}
 	return 0
 }
 
-func (x *LastAnomalyReportEnt) GetScoreList() []*LastAnomalyReportScore {
+func (x *DBStatsResponse) GetVlogSize() int64 {
 	if x != nil {
-		return x.ScoreList
+		return x.VlogSize
+	}
+	return 0
+}
+
+func (x *DBStatsResponse) GetUsage() []*DBUsageEnt {
+	if x != nil {
+		return x.Usage
 	}
 	return nil
 }
 
-type MonitorReportEnt struct {
+func (x *DBStatsResponse) GetLastGc() *DBMaintenanceEnt {
+	if x != nil {
+		return x.LastGc
+	}
+	return nil
+}
+
+type DBUsageEnt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Cpu           float64                `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Memory        float64                `protobuf:"fixed64,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	Load          float64                `protobuf:"fixed64,4,opt,name=load,proto3" json:"load,omitempty"`
-	Disk          float64                `protobuf:"fixed64,5,opt,name=disk,proto3" json:"disk,omitempty"`
-	Net           float64                `protobuf:"fixed64,6,opt,name=net,proto3" json:"net,omitempty"`
-	Bytes         int64                  `protobuf:"varint,7,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	DbSpeed       float64                `protobuf:"fixed64,8,opt,name=db_speed,json=dbSpeed,proto3" json:"db_speed,omitempty"`
-	DbSize        int64                  `protobuf:"varint,9,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Keys          int64                  `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Oldest        int64                  `protobuf:"varint,4,opt,name=oldest,proto3" json:"oldest,omitempty"`
+	Newest        int64                  `protobuf:"varint,5,opt,name=newest,proto3" json:"newest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonitorReportEnt) Reset() {
-	*x = MonitorReportEnt{}
-	mi := &file_twlogeye_proto_msgTypes[26]
+func (x *DBUsageEnt) Reset() {
+	*x = DBUsageEnt{}
+	mi := &file_twlogeye_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonitorReportEnt) String() string {
+func (x *DBUsageEnt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitorReportEnt) ProtoMessage() {}
+func (*DBUsageEnt) ProtoMessage() {}
 
-func (x *MonitorReportEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[26]
+func (x *DBUsageEnt) ProtoReflect() protoreflect.Message {
+	mi := &file_twlogeye_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1901,70 +4257,42 @@ func (x *MonitorReportEnt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitorReportEnt.ProtoReflect.Descriptor instead.
-func (*MonitorReportEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *MonitorReportEnt) GetTime() int64 {
-	if x != nil {
-		return x.Time
-	}
-	return 0
-}
-
-func (x *MonitorReportEnt) GetCpu() float64 {
-	if x != nil {
-		return x.Cpu
-	}
-	return 0
-}
-
-func (x *MonitorReportEnt) GetMemory() float64 {
-	if x != nil {
-		return x.Memory
-	}
-	return 0
-}
-
-func (x *MonitorReportEnt) GetLoad() float64 {
-	if x != nil {
-		return x.Load
-	}
-	return 0
+// Deprecated: Use DBUsageEnt.ProtoReflect.Descriptor instead.
+func (*DBUsageEnt) Descriptor() ([]byte, []int) {
+	return file_twlogeye_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *MonitorReportEnt) GetDisk() float64 {
+func (x *DBUsageEnt) GetType() string {
 	if x != nil {
-		return x.Disk
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *MonitorReportEnt) GetNet() float64 {
+func (x *DBUsageEnt) GetKeys() int64 {
 	if x != nil {
-		return x.Net
+		return x.Keys
 	}
 	return 0
 }
 
-func (x *MonitorReportEnt) GetBytes() int64 {
+func (x *DBUsageEnt) GetSize() int64 {
 	if x != nil {
-		return x.Bytes
+		return x.Size
 	}
 	return 0
 }
 
-func (x *MonitorReportEnt) GetDbSpeed() float64 {
+func (x *DBUsageEnt) GetOldest() int64 {
 	if x != nil {
-		return x.DbSpeed
+		return x.Oldest
 	}
 	return 0
 }
 
-func (x *MonitorReportEnt) GetDbSize() int64 {
+func (x *DBUsageEnt) GetNewest() int64 {
 	if x != nil {
-		return x.DbSize
+		return x.Newest
 	}
 	return 0
 }
@@ -1979,7 +4307,7 @@ type ClearRequest struct {
 
 func (x *ClearRequest) Reset() {
 	*x = ClearRequest{}
-	mi := &file_twlogeye_proto_msgTypes[27]
+	mi := &file_twlogeye_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1991,7 +4319,7 @@ func (x *ClearRequest) String() string {
 func (*ClearRequest) ProtoMessage() {}
 
 func (x *ClearRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[27]
+	mi := &file_twlogeye_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2004,7 +4332,7 @@ func (x *ClearRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearRequest.ProtoReflect.Descriptor instead.
 func (*ClearRequest) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{27}
+	return file_twlogeye_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ClearRequest) GetType() string {
@@ -2047,7 +4375,7 @@ type OTelMetricDataPointEnt struct {
 
 func (x *OTelMetricDataPointEnt) Reset() {
 	*x = OTelMetricDataPointEnt{}
-	mi := &file_twlogeye_proto_msgTypes[28]
+	mi := &file_twlogeye_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2059,7 +4387,7 @@ func (x *OTelMetricDataPointEnt) String() string {
 func (*OTelMetricDataPointEnt) ProtoMessage() {}
 
 func (x *OTelMetricDataPointEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[28]
+	mi := &file_twlogeye_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,7 +4400,7 @@ func (x *OTelMetricDataPointEnt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OTelMetricDataPointEnt.ProtoReflect.Descriptor instead.
 func (*OTelMetricDataPointEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{28}
+	return file_twlogeye_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *OTelMetricDataPointEnt) GetStart() int64 {
@@ -2199,7 +4527,7 @@ type OTelMetricEnt struct {
 
 func (x *OTelMetricEnt) Reset() {
 	*x = OTelMetricEnt{}
-	mi := &file_twlogeye_proto_msgTypes[29]
+	mi := &file_twlogeye_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +4539,7 @@ func (x *OTelMetricEnt) String() string {
 func (*OTelMetricEnt) ProtoMessage() {}
 
 func (x *OTelMetricEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[29]
+	mi := &file_twlogeye_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +4552,7 @@ func (x *OTelMetricEnt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OTelMetricEnt.ProtoReflect.Descriptor instead.
 func (*OTelMetricEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{29}
+	return file_twlogeye_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *OTelMetricEnt) GetHost() string {
@@ -2323,7 +4651,7 @@ type OTelMetricListEnt struct {
 
 func (x *OTelMetricListEnt) Reset() {
 	*x = OTelMetricListEnt{}
-	mi := &file_twlogeye_proto_msgTypes[30]
+	mi := &file_twlogeye_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2335,7 +4663,7 @@ func (x *OTelMetricListEnt) String() string {
 func (*OTelMetricListEnt) ProtoMessage() {}
 
 func (x *OTelMetricListEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[30]
+	mi := &file_twlogeye_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2348,7 +4676,7 @@ func (x *OTelMetricListEnt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OTelMetricListEnt.ProtoReflect.Descriptor instead.
 func (*OTelMetricListEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{30}
+	return file_twlogeye_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *OTelMetricListEnt) GetId() string {
@@ -2446,7 +4774,7 @@ type OTelTraceSpanEnt struct {
 
 func (x *OTelTraceSpanEnt) Reset() {
 	*x = OTelTraceSpanEnt{}
-	mi := &file_twlogeye_proto_msgTypes[31]
+	mi := &file_twlogeye_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2458,7 +4786,7 @@ func (x *OTelTraceSpanEnt) String() string {
 func (*OTelTraceSpanEnt) ProtoMessage() {}
 
 func (x *OTelTraceSpanEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[31]
+	mi := &file_twlogeye_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,7 +4799,7 @@ func (x *OTelTraceSpanEnt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OTelTraceSpanEnt.ProtoReflect.Descriptor instead.
 func (*OTelTraceSpanEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{31}
+	return file_twlogeye_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *OTelTraceSpanEnt) GetSpanId() string {
@@ -2558,7 +4886,7 @@ type OTelTraceEnt struct {
 
 func (x *OTelTraceEnt) Reset() {
 	*x = OTelTraceEnt{}
-	mi := &file_twlogeye_proto_msgTypes[32]
+	mi := &file_twlogeye_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2570,7 +4898,7 @@ func (x *OTelTraceEnt) String() string {
 func (*OTelTraceEnt) ProtoMessage() {}
 
 func (x *OTelTraceEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[32]
+	mi := &file_twlogeye_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2583,7 +4911,7 @@ func (x *OTelTraceEnt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OTelTraceEnt.ProtoReflect.Descriptor instead.
 func (*OTelTraceEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{32}
+	return file_twlogeye_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *OTelTraceEnt) GetTraceId() string {
@@ -2641,7 +4969,7 @@ type OTelTraceListEnt struct {
 
 func (x *OTelTraceListEnt) Reset() {
 	*x = OTelTraceListEnt{}
-	mi := &file_twlogeye_proto_msgTypes[33]
+	mi := &file_twlogeye_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2653,7 +4981,7 @@ func (x *OTelTraceListEnt) String() string {
 func (*OTelTraceListEnt) ProtoMessage() {}
 
 func (x *OTelTraceListEnt) ProtoReflect() protoreflect.Message {
-	mi := &file_twlogeye_proto_msgTypes[33]
+	mi := &file_twlogeye_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2666,7 +4994,7 @@ func (x *OTelTraceListEnt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OTelTraceListEnt.ProtoReflect.Descriptor instead.
 func (*OTelTraceListEnt) Descriptor() ([]byte, []int) {
-	return file_twlogeye_proto_rawDescGZIP(), []int{33}
+	return file_twlogeye_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *OTelTraceListEnt) GetTraceId() string {
@@ -2708,485 +5036,868 @@ var File_twlogeye_proto protoreflect.FileDescriptor
 
 var file_twlogeye_proto_rawDesc = string([]byte{
 	0x0a, 0x0e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x22, 0x4d, 0x0a, 0x0d, 0x4e, 0x6f,
+	0x12, 0x08, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x22, 0x7f, 0x0a, 0x0d, 0x4e, 0x6f,
 	0x66, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
 	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
 	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
 	0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6c, 0x6f, 0x67, 0x22, 0x66, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f,
-	0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x45, 0x0a, 0x0b,
-	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x22, 0xc3, 0x03, 0x0a, 0x0e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xb6, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x6f, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x10, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77,
+	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x02, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x72, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x61, 0x64, 0x22, 0xf0, 0x01, 0x0a,
+	0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x72, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x22,
+	0x7c, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xb7, 0x01,
+	0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x67,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x67,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x76, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x22, 0x45, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x3b, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b,
+	0x0a, 0x09, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x22, 0x46, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x02, 0x0a,
+	0x0f, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x77, 0x61, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x72, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0e, 0x74, 0x6f, 0x70,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x70,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x18,
+	0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x53, 0x63, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
 	0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72,
-	0x63, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6c, 0x6f, 0x67, 0x22, 0x3b, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
-	0x46, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x6c,
-	0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
 	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x65, 0x72, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
-	0x12, 0x32, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f,
-	0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x70,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
-	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a,
-	0x09, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x72, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x84, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
-	0x54, 0x72, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x07,
-	0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x18, 0x4e, 0x65, 0x74, 0x66, 0x6c,
-	0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22,
-	0x40, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x22, 0x3c, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0xdb, 0x08, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x63, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x61, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x70, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
-	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x75, 0x6d, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x75, 0x6d, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x73,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x6c, 0x6f, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x53, 0x0a, 0x14, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f,
-	0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45,
-	0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x63, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x63,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74,
-	0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x45, 0x6e, 0x74, 0x52, 0x0f, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x63, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x13, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74,
-	0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x6f, 0x70, 0x49, 0x70, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x11, 0x74, 0x6f, 0x70, 0x5f, 0x69,
-	0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65,
-	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x45, 0x6e, 0x74, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x49, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x15, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
-	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e,
-	0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x12, 0x74, 0x6f, 0x70, 0x46, 0x6c, 0x6f, 0x77,
-	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x13, 0x74,
-	0x6f, 0x70, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x5a, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x22, 0xaf, 0x03, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x49,
+	0x66, 0x45, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x69, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x69, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x75, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x69, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x42, 0x70, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x70, 0x70,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x69, 0x6e, 0x50, 0x70, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x50, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x75, 0x74,
+	0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x69, 0x6e, 0x55, 0x74, 0x69, 0x6c,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x12, 0x48, 0x0a, 0x0f, 0x74,
+	0x6f, 0x70, 0x5f, 0x74, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xeb, 0x0a, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x61, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x61,
+	0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x75, 0x6d, 0x62,
+	0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x75, 0x6d, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x6f, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x53, 0x0a, 0x14, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x61,
+	0x63, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x63,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x74,
+	0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x0f, 0x74, 0x6f, 0x70, 0x4d, 0x61,
+	0x63, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x13, 0x74, 0x6f,
+	0x70, 0x5f, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x6f, 0x70,
+	0x49, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a,
+	0x11, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
 	0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x6f, 0x70, 0x46,
-	0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x11,
-	0x74, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0f, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x75,
-	0x6d, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e,
-	0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e,
-	0x74, 0x52, 0x10, 0x74, 0x6f, 0x70, 0x46, 0x75, 0x6d, 0x62, 0x6c, 0x65, 0x53, 0x72, 0x63, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c,
-	0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x48, 0x6f, 0x73,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x63,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77,
-	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x4c, 0x6f,
-	0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c,
-	0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0e, 0x74,
-	0x6f, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x7e, 0x0a,
-	0x13, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x02,
-	0x0a, 0x15, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e,
-	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72,
-	0x6d, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79,
-	0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43,
-	0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79,
-	0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x4f, 0x54, 0x65, 0x6c, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x03, 0x0a,
-	0x0d, 0x4f, 0x54, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61,
-	0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74,
-	0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x3e, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45,
-	0x6e, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x69,
-	0x63, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x0e, 0x4d, 0x71, 0x74, 0x74, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x49,
+	0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x15, 0x74, 0x6f,
+	0x70, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x12, 0x74,
+	0x6f, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x4f, 0x0a, 0x13, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x52, 0x10, 0x74, 0x6f, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0f, 0x74, 0x6f, 0x70,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x13,
+	0x74, 0x6f, 0x70, 0x5f, 0x66, 0x75, 0x6d, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x6f, 0x70, 0x46, 0x75, 0x6d, 0x62,
+	0x6c, 0x65, 0x53, 0x72, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x74, 0x6f, 0x70,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0b,
+	0x74, 0x6f, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x74,
+	0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52,
+	0x0a, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x74,
+	0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x45, 0x6e, 0x74, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x63, 0x61,
+	0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x53,
+	0x63, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x52, 0x0a, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x7a, 0x6f, 0x6e, 0x65, 0x5f,
+	0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x5a,
+	0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x52, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x12, 0x2f, 0x0a, 0x07, 0x69, 0x66, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e,
+	0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x66, 0x45, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x66, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x13, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x15, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x72,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x6f, 0x70,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x4f, 0x54,
+	0x65, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x88, 0x03, 0x0a, 0x0d, 0x4f, 0x54, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72,
 	0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79,
-	0x65, 0x2e, 0x4d, 0x71, 0x74, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74,
-	0x52, 0x07, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x41, 0x6e, 0x6f,
-	0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3c, 0x0a,
-	0x10, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x77, 0x61, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f,
+	0x54, 0x65, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a,
+	0x0e, 0x4d, 0x71, 0x74, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x4d, 0x71, 0x74,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4d, 0x71, 0x74, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x52, 0x0a, 0x14, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3c, 0x0a, 0x10, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x56, 0x0a, 0x16, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x6b, 0x0a, 0x14, 0x4c, 0x61, 0x73,
+	0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x56, 0x0a, 0x16, 0x4c,
-	0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x22, 0x6b, 0x0a, 0x14, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61,
-	0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xed, 0x02, 0x0a, 0x10, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
 	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
-	0x3f, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c,
-	0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0xd4, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6e,
-	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x62, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x62, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
-	0x62, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa6, 0x03, 0x0a, 0x16, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
-	0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e,
-	0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
-	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x03, 0x6d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x75, 0x67, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x61, 0x75, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x7a, 0x65, 0x72, 0x6f,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x7a, 0x65,
-	0x72, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x7a, 0x65, 0x72, 0x6f, 0x5f,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0d, 0x7a, 0x65, 0x72, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xb4,
-	0x02, 0x0a, 0x0d, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x45, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e,
-	0x69, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x61, 0x74,
-	0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x85, 0x02, 0x0a, 0x11, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x83, 0x02,
-	0x0a, 0x10, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45,
-	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x64, 0x75, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x45, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x64, 0x75, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x70, 0x61,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61,
-	0x6e, 0x45, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c,
-	0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22,
-	0x7b, 0x0a, 0x10, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x03, 0x64, 0x75, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x32, 0xf1, 0x0d, 0x0a,
-	0x0f, 0x54, 0x57, 0x4c, 0x6f, 0x67, 0x45, 0x79, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x32, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x70,
+	0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x73,
+	0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x62, 0x5f,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x62, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x44, 0x42, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45,
+	0x6e, 0x74, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x73, 0x6d,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x73, 0x6d,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x6c, 0x6f, 0x67, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x63, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x44, 0x42,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x52, 0x06,
+	0x6c, 0x61, 0x73, 0x74, 0x47, 0x63, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x44, 0x42, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6c,
+	0x61, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x9c, 0x01, 0x0a, 0x0a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x22, 0xf6, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x71, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x77, 0x6c,
+	0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x0e,
+	0x4c, 0x6f, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a,
+	0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0xaa, 0x01, 0x0a,
+	0x0f, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x73, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6c, 0x73, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76,
+	0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x76, 0x6c, 0x6f, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x44, 0x42, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x52, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x44, 0x42, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e,
+	0x74, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x47, 0x63, 0x22, 0x78, 0x0a, 0x0a, 0x44, 0x42, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x65, 0x77, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x77,
+	0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xa6, 0x03, 0x0a, 0x16, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x44, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x75, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x67, 0x61, 0x75, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x7a, 0x65, 0x72, 0x6f, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x7a, 0x65, 0x72,
+	0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xb4, 0x02, 0x0a, 0x0d, 0x4f,
+	0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x45, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x41,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f,
+	0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73,
+	0x74, 0x22, 0x85, 0x02, 0x0a, 0x11, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x10, 0x4f, 0x54,
+	0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x75, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x64, 0x75, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22,
+	0xa9, 0x01, 0x0a, 0x0c, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x64, 0x75, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x6e, 0x74,
+	0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x4f,
+	0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x64, 0x75, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x32, 0xc6, 0x15, 0x0a, 0x0f, 0x54, 0x57, 0x4c,
+	0x6f, 0x67, 0x45, 0x79, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x04,
+	0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c,
+	0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x74, 0x77,
+	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44,
+	0x42, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
 	0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0f,
-	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6c,
-	0x65, 0x61, 0x72, 0x44, 0x42, 0x12, 0x16, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
-	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
-	0x4e, 0x6f, 0x66, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79,
-	0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74,
-	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x6c,
-	0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x53, 0x79, 0x73,
-	0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x41,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79,
-	0x65, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
-	0x74, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77,
-	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77,
-	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x74,
-	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x66,
-	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
-	0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65,
-	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01,
-	0x12, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x66, 0x6c,
-	0x6f, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
-	0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x43, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x66, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c,
+	0x6f, 0x67, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x53, 0x79,
+	0x73, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x43, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17,
 	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x65, 0x79, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x74, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x4e, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x74, 0x12, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c,
+	0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79,
+	0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c,
 	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x4f, 0x54, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c,
+	0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c,
 	0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f,
-	0x54, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3d,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x52, 0x65, 0x70,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4d,
+	0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3d,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70,
 	0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45,
 	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
-	0x4f, 0x54, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x43, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17,
-	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
-	0x30, 0x01, 0x12, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x71, 0x74,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
-	0x74, 0x12, 0x50, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
-	0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x4d, 0x71, 0x74, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x50, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1e, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x47, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x65, 0x79, 0x65, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
-	0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
-	0x74, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e,
-	0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77,
-	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x74,
-	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6d,
-	0x61, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
-	0x67, 0x65, 0x79, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x74, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77,
+	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f,
+	0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e,
+	0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3d, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x13,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f,
+	0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x45, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65,
+	0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x13, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12,
 	0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54,
-	0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x30,
-	0x01, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x12, 0x13, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
-	0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x45, 0x6e, 0x74,
-	0x12, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
-	0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
-	0x74, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
-	0x65, 0x79, 0x65, 0x2e, 0x4f, 0x54, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74,
-	0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
-	0x77, 0x73, 0x6e, 0x6d, 0x70, 0x2f, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3f, 0x0a,
+	0x0d, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x13,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x66, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x4e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45,
+	0x6e, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x13, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74,
+	0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1d, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x42, 0x0a, 0x0f, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77,
+	0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65,
+	0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x44, 0x42, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x65, 0x79, 0x65, 0x2e, 0x44, 0x42, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x0f,
+	0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x44, 0x42, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x44, 0x42, 0x12, 0x0f, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67,
+	0x65, 0x79, 0x65, 0x2e, 0x44, 0x42, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x42,
+	0x12, 0x17, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x77, 0x6c,
+	0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x77, 0x6c, 0x6f,
+	0x67, 0x65, 0x79, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f,
+	0x67, 0x12, 0x14, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65,
+	0x79, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x74, 0x30,
+	0x01, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x77, 0x73, 0x6e, 0x6d, 0x70, 0x2f, 0x74, 0x77, 0x6c, 0x6f, 0x67, 0x65, 0x79, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -3201,123 +5912,192 @@ func file_twlogeye_proto_rawDescGZIP() []byte {
 	return file_twlogeye_proto_rawDescData
 }
 
-var file_twlogeye_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_twlogeye_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_twlogeye_proto_goTypes = []any{
 	(*NofifyRequest)(nil),            // 0: twlogeye.NofifyRequest
 	(*NotifyResponse)(nil),           // 1: twlogeye.NotifyResponse
-	(*LogRequest)(nil),               // 2: twlogeye.LogRequest
-	(*LogResponse)(nil),              // 3: twlogeye.LogResponse
-	(*ControlResponse)(nil),          // 4: twlogeye.ControlResponse
-	(*Empty)(nil),                    // 5: twlogeye.Empty
-	(*IDRequest)(nil),                // 6: twlogeye.IDRequest
-	(*ReportRequest)(nil),            // 7: twlogeye.ReportRequest
-	(*LogSummaryEnt)(nil),            // 8: twlogeye.LogSummaryEnt
-	(*SyslogReportEnt)(nil),          // 9: twlogeye.SyslogReportEnt
-	(*TrapSummaryEnt)(nil),           // 10: twlogeye.TrapSummaryEnt
-	(*TrapReportEnt)(nil),            // 11: twlogeye.TrapReportEnt
-	(*NetflowPacketsSummaryEnt)(nil), // 12: twlogeye.NetflowPacketsSummaryEnt
-	(*NetflowBytesSummaryEnt)(nil),   // 13: twlogeye.NetflowBytesSummaryEnt
-	(*NetflowKeyCountEnt)(nil),       // 14: twlogeye.NetflowKeyCountEnt
-	(*NetflowReportEnt)(nil),         // 15: twlogeye.NetflowReportEnt
-	(*WindowsEventSummary)(nil),      // 16: twlogeye.WindowsEventSummary
-	(*WindowsEventReportEnt)(nil),    // 17: twlogeye.WindowsEventReportEnt
-	(*OTelSummaryEnt)(nil),           // 18: twlogeye.OTelSummaryEnt
-	(*OTelReportEnt)(nil),            // 19: twlogeye.OTelReportEnt
-	(*MqttSummaryEnt)(nil),           // 20: twlogeye.MqttSummaryEnt
-	(*MqttReportEnt)(nil),            // 21: twlogeye.MqttReportEnt
-	(*AnomalyReportRequest)(nil),     // 22: twlogeye.AnomalyReportRequest
-	(*AnomalyReportEnt)(nil),         // 23: twlogeye.AnomalyReportEnt
-	(*LastAnomalyReportScore)(nil),   // 24: twlogeye.LastAnomalyReportScore
-	(*LastAnomalyReportEnt)(nil),     // 25: twlogeye.LastAnomalyReportEnt
-	(*MonitorReportEnt)(nil),         // 26: twlogeye.MonitorReportEnt
-	(*ClearRequest)(nil),             // 27: twlogeye.ClearRequest
-	(*OTelMetricDataPointEnt)(nil),   // 28: twlogeye.OTelMetricDataPointEnt
-	(*OTelMetricEnt)(nil),            // 29: twlogeye.OTelMetricEnt
-	(*OTelMetricListEnt)(nil),        // 30: twlogeye.OTelMetricListEnt
-	(*OTelTraceSpanEnt)(nil),         // 31: twlogeye.OTelTraceSpanEnt
-	(*OTelTraceEnt)(nil),             // 32: twlogeye.OTelTraceEnt
-	(*OTelTraceListEnt)(nil),         // 33: twlogeye.OTelTraceListEnt
+	(*IncidentEnt)(nil),              // 2: twlogeye.IncidentEnt
+	(*NotifyStateRequest)(nil),       // 3: twlogeye.NotifyStateRequest
+	(*NotifyCommentEnt)(nil),         // 4: twlogeye.NotifyCommentEnt
+	(*NotifyHistoryEnt)(nil),         // 5: twlogeye.NotifyHistoryEnt
+	(*NotifyWorkflowEnt)(nil),        // 6: twlogeye.NotifyWorkflowEnt
+	(*NotifyDeliveryEnt)(nil),        // 7: twlogeye.NotifyDeliveryEnt
+	(*NotifyActionEnt)(nil),          // 8: twlogeye.NotifyActionEnt
+	(*NotifyOutboxEnt)(nil),          // 9: twlogeye.NotifyOutboxEnt
+	(*NotifyEscalationEnt)(nil),      // 10: twlogeye.NotifyEscalationEnt
+	(*LogRequest)(nil),               // 11: twlogeye.LogRequest
+	(*LogStatsRequest)(nil),          // 12: twlogeye.LogStatsRequest
+	(*LogStatsEnt)(nil),              // 13: twlogeye.LogStatsEnt
+	(*LogFieldStatsEnt)(nil),         // 14: twlogeye.LogFieldStatsEnt
+	(*LogResponse)(nil),              // 15: twlogeye.LogResponse
+	(*ControlResponse)(nil),          // 16: twlogeye.ControlResponse
+	(*Empty)(nil),                    // 17: twlogeye.Empty
+	(*IDRequest)(nil),                // 18: twlogeye.IDRequest
+	(*ReportRequest)(nil),            // 19: twlogeye.ReportRequest
+	(*LogSummaryEnt)(nil),            // 20: twlogeye.LogSummaryEnt
+	(*SyslogReportEnt)(nil),          // 21: twlogeye.SyslogReportEnt
+	(*TrapSummaryEnt)(nil),           // 22: twlogeye.TrapSummaryEnt
+	(*TrapReportEnt)(nil),            // 23: twlogeye.TrapReportEnt
+	(*NetflowPacketsSummaryEnt)(nil), // 24: twlogeye.NetflowPacketsSummaryEnt
+	(*NetflowBytesSummaryEnt)(nil),   // 25: twlogeye.NetflowBytesSummaryEnt
+	(*NetflowKeyCountEnt)(nil),       // 26: twlogeye.NetflowKeyCountEnt
+	(*NetflowScanEnt)(nil),           // 27: twlogeye.NetflowScanEnt
+	(*NetflowBeaconEnt)(nil),         // 28: twlogeye.NetflowBeaconEnt
+	(*NetflowZoneEnt)(nil),           // 29: twlogeye.NetflowZoneEnt
+	(*NetflowIfEnt)(nil),             // 30: twlogeye.NetflowIfEnt
+	(*NetflowReportEnt)(nil),         // 31: twlogeye.NetflowReportEnt
+	(*WindowsEventSummary)(nil),      // 32: twlogeye.WindowsEventSummary
+	(*WindowsEventReportEnt)(nil),    // 33: twlogeye.WindowsEventReportEnt
+	(*OTelSummaryEnt)(nil),           // 34: twlogeye.OTelSummaryEnt
+	(*OTelReportEnt)(nil),            // 35: twlogeye.OTelReportEnt
+	(*MqttSummaryEnt)(nil),           // 36: twlogeye.MqttSummaryEnt
+	(*MqttReportEnt)(nil),            // 37: twlogeye.MqttReportEnt
+	(*AnomalyReportRequest)(nil),     // 38: twlogeye.AnomalyReportRequest
+	(*AnomalyReportEnt)(nil),         // 39: twlogeye.AnomalyReportEnt
+	(*LastAnomalyReportScore)(nil),   // 40: twlogeye.LastAnomalyReportScore
+	(*LastAnomalyReportEnt)(nil),     // 41: twlogeye.LastAnomalyReportEnt
+	(*MonitorReportEnt)(nil),         // 42: twlogeye.MonitorReportEnt
+	(*DBMaintenanceEnt)(nil),         // 43: twlogeye.DBMaintenanceEnt
+	(*ArchiveEnt)(nil),               // 44: twlogeye.ArchiveEnt
+	(*LogVerifyEnt)(nil),             // 45: twlogeye.LogVerifyEnt
+	(*LogVerifyIssue)(nil),           // 46: twlogeye.LogVerifyIssue
+	(*BackupRequest)(nil),            // 47: twlogeye.BackupRequest
+	(*BackupChunk)(nil),              // 48: twlogeye.BackupChunk
+	(*DBStatsResponse)(nil),          // 49: twlogeye.DBStatsResponse
+	(*DBUsageEnt)(nil),               // 50: twlogeye.DBUsageEnt
+	(*ClearRequest)(nil),             // 51: twlogeye.ClearRequest
+	(*OTelMetricDataPointEnt)(nil),   // 52: twlogeye.OTelMetricDataPointEnt
+	(*OTelMetricEnt)(nil),            // 53: twlogeye.OTelMetricEnt
+	(*OTelMetricListEnt)(nil),        // 54: twlogeye.OTelMetricListEnt
+	(*OTelTraceSpanEnt)(nil),         // 55: twlogeye.OTelTraceSpanEnt
+	(*OTelTraceEnt)(nil),             // 56: twlogeye.OTelTraceEnt
+	(*OTelTraceListEnt)(nil),         // 57: twlogeye.OTelTraceListEnt
 }
 var file_twlogeye_proto_depIdxs = []int32{
-	8,  // 0: twlogeye.SyslogReportEnt.top_list:type_name -> twlogeye.LogSummaryEnt
-	8,  // 1: twlogeye.SyslogReportEnt.top_error_list:type_name -> twlogeye.LogSummaryEnt
-	10, // 2: twlogeye.TrapReportEnt.top_list:type_name -> twlogeye.TrapSummaryEnt
-	12, // 3: twlogeye.NetflowReportEnt.top_mac_packets_list:type_name -> twlogeye.NetflowPacketsSummaryEnt
-	13, // 4: twlogeye.NetflowReportEnt.top_mac_bytes_list:type_name -> twlogeye.NetflowBytesSummaryEnt
-	12, // 5: twlogeye.NetflowReportEnt.top_ip_packets_list:type_name -> twlogeye.NetflowPacketsSummaryEnt
-	13, // 6: twlogeye.NetflowReportEnt.top_ip_bytes_list:type_name -> twlogeye.NetflowBytesSummaryEnt
-	12, // 7: twlogeye.NetflowReportEnt.top_flow_packets_list:type_name -> twlogeye.NetflowPacketsSummaryEnt
-	13, // 8: twlogeye.NetflowReportEnt.top_flow_bytes_list:type_name -> twlogeye.NetflowBytesSummaryEnt
-	14, // 9: twlogeye.NetflowReportEnt.top_protocol_list:type_name -> twlogeye.NetflowKeyCountEnt
-	14, // 10: twlogeye.NetflowReportEnt.top_fumble_src_list:type_name -> twlogeye.NetflowKeyCountEnt
-	14, // 11: twlogeye.NetflowReportEnt.top_host_list:type_name -> twlogeye.NetflowKeyCountEnt
-	14, // 12: twlogeye.NetflowReportEnt.top_loc_list:type_name -> twlogeye.NetflowKeyCountEnt
-	14, // 13: twlogeye.NetflowReportEnt.top_country_list:type_name -> twlogeye.NetflowKeyCountEnt
-	16, // 14: twlogeye.WindowsEventReportEnt.top_list:type_name -> twlogeye.WindowsEventSummary
-	16, // 15: twlogeye.WindowsEventReportEnt.top_error_list:type_name -> twlogeye.WindowsEventSummary
-	18, // 16: twlogeye.OTelReportEnt.top_list:type_name -> twlogeye.OTelSummaryEnt
-	18, // 17: twlogeye.OTelReportEnt.top_error_list:type_name -> twlogeye.OTelSummaryEnt
-	20, // 18: twlogeye.MqttReportEnt.top_list:type_name -> twlogeye.MqttSummaryEnt
-	24, // 19: twlogeye.LastAnomalyReportEnt.score_list:type_name -> twlogeye.LastAnomalyReportScore
-	28, // 20: twlogeye.OTelMetricEnt.data_points:type_name -> twlogeye.OTelMetricDataPointEnt
-	31, // 21: twlogeye.OTelTraceEnt.spans:type_name -> twlogeye.OTelTraceSpanEnt
-	5,  // 22: twlogeye.TWLogEyeService.Stop:input_type -> twlogeye.Empty
-	5,  // 23: twlogeye.TWLogEyeService.Reload:input_type -> twlogeye.Empty
-	27, // 24: twlogeye.TWLogEyeService.ClearDB:input_type -> twlogeye.ClearRequest
-	5,  // 25: twlogeye.TWLogEyeService.WatchNotify:input_type -> twlogeye.Empty
-	0,  // 26: twlogeye.TWLogEyeService.SearchNotify:input_type -> twlogeye.NofifyRequest
-	2,  // 27: twlogeye.TWLogEyeService.SearchLog:input_type -> twlogeye.LogRequest
-	7,  // 28: twlogeye.TWLogEyeService.GetSyslogReport:input_type -> twlogeye.ReportRequest
-	5,  // 29: twlogeye.TWLogEyeService.GetLastSyslogReport:input_type -> twlogeye.Empty
-	7,  // 30: twlogeye.TWLogEyeService.GetTrapReport:input_type -> twlogeye.ReportRequest
-	5,  // 31: twlogeye.TWLogEyeService.GetLastTrapReport:input_type -> twlogeye.Empty
-	7,  // 32: twlogeye.TWLogEyeService.GetNetflowReport:input_type -> twlogeye.ReportRequest
-	5,  // 33: twlogeye.TWLogEyeService.GetLastNetflowReport:input_type -> twlogeye.Empty
-	7,  // 34: twlogeye.TWLogEyeService.GetWindowsEventReport:input_type -> twlogeye.ReportRequest
-	5,  // 35: twlogeye.TWLogEyeService.GetLastWindowsEventReport:input_type -> twlogeye.Empty
-	7,  // 36: twlogeye.TWLogEyeService.GetOTelReport:input_type -> twlogeye.ReportRequest
-	5,  // 37: twlogeye.TWLogEyeService.GetLastOTelReport:input_type -> twlogeye.Empty
-	7,  // 38: twlogeye.TWLogEyeService.GetMqttReport:input_type -> twlogeye.ReportRequest
-	5,  // 39: twlogeye.TWLogEyeService.GetLastMqttReport:input_type -> twlogeye.Empty
-	22, // 40: twlogeye.TWLogEyeService.GetAnomalyReport:input_type -> twlogeye.AnomalyReportRequest
-	5,  // 41: twlogeye.TWLogEyeService.GetLastAnomalyReport:input_type -> twlogeye.Empty
-	7,  // 42: twlogeye.TWLogEyeService.GetMonitorReport:input_type -> twlogeye.ReportRequest
-	5,  // 43: twlogeye.TWLogEyeService.GetLastMonitorReport:input_type -> twlogeye.Empty
-	5,  // 44: twlogeye.TWLogEyeService.GetOTelMetricList:input_type -> twlogeye.Empty
-	6,  // 45: twlogeye.TWLogEyeService.GetOTelMetric:input_type -> twlogeye.IDRequest
-	5,  // 46: twlogeye.TWLogEyeService.GetOTelTraceList:input_type -> twlogeye.Empty
-	6,  // 47: twlogeye.TWLogEyeService.GetOTelTrace:input_type -> twlogeye.IDRequest
-	4,  // 48: twlogeye.TWLogEyeService.Stop:output_type -> twlogeye.ControlResponse
-	4,  // 49: twlogeye.TWLogEyeService.Reload:output_type -> twlogeye.ControlResponse
-	4,  // 50: twlogeye.TWLogEyeService.ClearDB:output_type -> twlogeye.ControlResponse
-	1,  // 51: twlogeye.TWLogEyeService.WatchNotify:output_type -> twlogeye.NotifyResponse
-	1,  // 52: twlogeye.TWLogEyeService.SearchNotify:output_type -> twlogeye.NotifyResponse
-	3,  // 53: twlogeye.TWLogEyeService.SearchLog:output_type -> twlogeye.LogResponse
-	9,  // 54: twlogeye.TWLogEyeService.GetSyslogReport:output_type -> twlogeye.SyslogReportEnt
-	9,  // 55: twlogeye.TWLogEyeService.GetLastSyslogReport:output_type -> twlogeye.SyslogReportEnt
-	11, // 56: twlogeye.TWLogEyeService.GetTrapReport:output_type -> twlogeye.TrapReportEnt
-	11, // 57: twlogeye.TWLogEyeService.GetLastTrapReport:output_type -> twlogeye.TrapReportEnt
-	15, // 58: twlogeye.TWLogEyeService.GetNetflowReport:output_type -> twlogeye.NetflowReportEnt
-	15, // 59: twlogeye.TWLogEyeService.GetLastNetflowReport:output_type -> twlogeye.NetflowReportEnt
-	17, // 60: twlogeye.TWLogEyeService.GetWindowsEventReport:output_type -> twlogeye.WindowsEventReportEnt
-	17, // 61: twlogeye.TWLogEyeService.GetLastWindowsEventReport:output_type -> twlogeye.WindowsEventReportEnt
-	19, // 62: twlogeye.TWLogEyeService.GetOTelReport:output_type -> twlogeye.OTelReportEnt
-	19, // 63: twlogeye.TWLogEyeService.GetLastOTelReport:output_type -> twlogeye.OTelReportEnt
-	21, // 64: twlogeye.TWLogEyeService.GetMqttReport:output_type -> twlogeye.MqttReportEnt
-	21, // 65: twlogeye.TWLogEyeService.GetLastMqttReport:output_type -> twlogeye.MqttReportEnt
-	23, // 66: twlogeye.TWLogEyeService.GetAnomalyReport:output_type -> twlogeye.AnomalyReportEnt
-	25, // 67: twlogeye.TWLogEyeService.GetLastAnomalyReport:output_type -> twlogeye.LastAnomalyReportEnt
-	26, // 68: twlogeye.TWLogEyeService.GetMonitorReport:output_type -> twlogeye.MonitorReportEnt
-	26, // 69: twlogeye.TWLogEyeService.GetLastMonitorReport:output_type -> twlogeye.MonitorReportEnt
-	30, // 70: twlogeye.TWLogEyeService.GetOTelMetricList:output_type -> twlogeye.OTelMetricListEnt
-	29, // 71: twlogeye.TWLogEyeService.GetOTelMetric:output_type -> twlogeye.OTelMetricEnt
-	33, // 72: twlogeye.TWLogEyeService.GetOTelTraceList:output_type -> twlogeye.OTelTraceListEnt
-	32, // 73: twlogeye.TWLogEyeService.GetOTelTrace:output_type -> twlogeye.OTelTraceEnt
-	48, // [48:74] is the sub-list for method output_type
-	22, // [22:48] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	7,  // 0: twlogeye.NotifyResponse.delivery:type_name -> twlogeye.NotifyDeliveryEnt
+	8,  // 1: twlogeye.NotifyResponse.actions:type_name -> twlogeye.NotifyActionEnt
+	4,  // 2: twlogeye.NotifyWorkflowEnt.comments:type_name -> twlogeye.NotifyCommentEnt
+	5,  // 3: twlogeye.NotifyWorkflowEnt.history:type_name -> twlogeye.NotifyHistoryEnt
+	14, // 4: twlogeye.LogStatsEnt.stats:type_name -> twlogeye.LogFieldStatsEnt
+	20, // 5: twlogeye.SyslogReportEnt.top_list:type_name -> twlogeye.LogSummaryEnt
+	20, // 6: twlogeye.SyslogReportEnt.top_error_list:type_name -> twlogeye.LogSummaryEnt
+	22, // 7: twlogeye.TrapReportEnt.top_list:type_name -> twlogeye.TrapSummaryEnt
+	25, // 8: twlogeye.NetflowIfEnt.top_talker_list:type_name -> twlogeye.NetflowBytesSummaryEnt
+	24, // 9: twlogeye.NetflowReportEnt.top_mac_packets_list:type_name -> twlogeye.NetflowPacketsSummaryEnt
+	25, // 10: twlogeye.NetflowReportEnt.top_mac_bytes_list:type_name -> twlogeye.NetflowBytesSummaryEnt
+	24, // 11: twlogeye.NetflowReportEnt.top_ip_packets_list:type_name -> twlogeye.NetflowPacketsSummaryEnt
+	25, // 12: twlogeye.NetflowReportEnt.top_ip_bytes_list:type_name -> twlogeye.NetflowBytesSummaryEnt
+	24, // 13: twlogeye.NetflowReportEnt.top_flow_packets_list:type_name -> twlogeye.NetflowPacketsSummaryEnt
+	25, // 14: twlogeye.NetflowReportEnt.top_flow_bytes_list:type_name -> twlogeye.NetflowBytesSummaryEnt
+	26, // 15: twlogeye.NetflowReportEnt.top_protocol_list:type_name -> twlogeye.NetflowKeyCountEnt
+	26, // 16: twlogeye.NetflowReportEnt.top_fumble_src_list:type_name -> twlogeye.NetflowKeyCountEnt
+	26, // 17: twlogeye.NetflowReportEnt.top_host_list:type_name -> twlogeye.NetflowKeyCountEnt
+	26, // 18: twlogeye.NetflowReportEnt.top_loc_list:type_name -> twlogeye.NetflowKeyCountEnt
+	26, // 19: twlogeye.NetflowReportEnt.top_country_list:type_name -> twlogeye.NetflowKeyCountEnt
+	27, // 20: twlogeye.NetflowReportEnt.scan_list:type_name -> twlogeye.NetflowScanEnt
+	28, // 21: twlogeye.NetflowReportEnt.beacon_list:type_name -> twlogeye.NetflowBeaconEnt
+	29, // 22: twlogeye.NetflowReportEnt.zone_matrix:type_name -> twlogeye.NetflowZoneEnt
+	30, // 23: twlogeye.NetflowReportEnt.if_list:type_name -> twlogeye.NetflowIfEnt
+	32, // 24: twlogeye.WindowsEventReportEnt.top_list:type_name -> twlogeye.WindowsEventSummary
+	32, // 25: twlogeye.WindowsEventReportEnt.top_error_list:type_name -> twlogeye.WindowsEventSummary
+	34, // 26: twlogeye.OTelReportEnt.top_list:type_name -> twlogeye.OTelSummaryEnt
+	34, // 27: twlogeye.OTelReportEnt.top_error_list:type_name -> twlogeye.OTelSummaryEnt
+	36, // 28: twlogeye.MqttReportEnt.top_list:type_name -> twlogeye.MqttSummaryEnt
+	40, // 29: twlogeye.LastAnomalyReportEnt.score_list:type_name -> twlogeye.LastAnomalyReportScore
+	50, // 30: twlogeye.MonitorReportEnt.usage:type_name -> twlogeye.DBUsageEnt
+	43, // 31: twlogeye.MonitorReportEnt.last_gc:type_name -> twlogeye.DBMaintenanceEnt
+	46, // 32: twlogeye.LogVerifyEnt.issues:type_name -> twlogeye.LogVerifyIssue
+	50, // 33: twlogeye.DBStatsResponse.usage:type_name -> twlogeye.DBUsageEnt
+	43, // 34: twlogeye.DBStatsResponse.last_gc:type_name -> twlogeye.DBMaintenanceEnt
+	52, // 35: twlogeye.OTelMetricEnt.data_points:type_name -> twlogeye.OTelMetricDataPointEnt
+	55, // 36: twlogeye.OTelTraceEnt.spans:type_name -> twlogeye.OTelTraceSpanEnt
+	17, // 37: twlogeye.TWLogEyeService.Stop:input_type -> twlogeye.Empty
+	17, // 38: twlogeye.TWLogEyeService.Reload:input_type -> twlogeye.Empty
+	51, // 39: twlogeye.TWLogEyeService.ClearDB:input_type -> twlogeye.ClearRequest
+	17, // 40: twlogeye.TWLogEyeService.WatchNotify:input_type -> twlogeye.Empty
+	0,  // 41: twlogeye.TWLogEyeService.SearchNotify:input_type -> twlogeye.NofifyRequest
+	11, // 42: twlogeye.TWLogEyeService.SearchLog:input_type -> twlogeye.LogRequest
+	19, // 43: twlogeye.TWLogEyeService.GetSyslogReport:input_type -> twlogeye.ReportRequest
+	17, // 44: twlogeye.TWLogEyeService.GetLastSyslogReport:input_type -> twlogeye.Empty
+	19, // 45: twlogeye.TWLogEyeService.GetTrapReport:input_type -> twlogeye.ReportRequest
+	17, // 46: twlogeye.TWLogEyeService.GetLastTrapReport:input_type -> twlogeye.Empty
+	19, // 47: twlogeye.TWLogEyeService.GetNetflowReport:input_type -> twlogeye.ReportRequest
+	17, // 48: twlogeye.TWLogEyeService.GetLastNetflowReport:input_type -> twlogeye.Empty
+	19, // 49: twlogeye.TWLogEyeService.GetWindowsEventReport:input_type -> twlogeye.ReportRequest
+	17, // 50: twlogeye.TWLogEyeService.GetLastWindowsEventReport:input_type -> twlogeye.Empty
+	19, // 51: twlogeye.TWLogEyeService.GetOTelReport:input_type -> twlogeye.ReportRequest
+	17, // 52: twlogeye.TWLogEyeService.GetLastOTelReport:input_type -> twlogeye.Empty
+	19, // 53: twlogeye.TWLogEyeService.GetMqttReport:input_type -> twlogeye.ReportRequest
+	17, // 54: twlogeye.TWLogEyeService.GetLastMqttReport:input_type -> twlogeye.Empty
+	38, // 55: twlogeye.TWLogEyeService.GetAnomalyReport:input_type -> twlogeye.AnomalyReportRequest
+	17, // 56: twlogeye.TWLogEyeService.GetLastAnomalyReport:input_type -> twlogeye.Empty
+	19, // 57: twlogeye.TWLogEyeService.GetMonitorReport:input_type -> twlogeye.ReportRequest
+	17, // 58: twlogeye.TWLogEyeService.GetLastMonitorReport:input_type -> twlogeye.Empty
+	17, // 59: twlogeye.TWLogEyeService.GetOTelMetricList:input_type -> twlogeye.Empty
+	18, // 60: twlogeye.TWLogEyeService.GetOTelMetric:input_type -> twlogeye.IDRequest
+	17, // 61: twlogeye.TWLogEyeService.GetOTelTraceList:input_type -> twlogeye.Empty
+	18, // 62: twlogeye.TWLogEyeService.GetOTelTrace:input_type -> twlogeye.IDRequest
+	17, // 63: twlogeye.TWLogEyeService.GetNotifyOutbox:input_type -> twlogeye.Empty
+	18, // 64: twlogeye.TWLogEyeService.RedriveNotify:input_type -> twlogeye.IDRequest
+	0,  // 65: twlogeye.TWLogEyeService.SearchIncident:input_type -> twlogeye.NofifyRequest
+	3,  // 66: twlogeye.TWLogEyeService.UpdateNotifyState:input_type -> twlogeye.NotifyStateRequest
+	18, // 67: twlogeye.TWLogEyeService.GetNotifyWorkflow:input_type -> twlogeye.IDRequest
+	17, // 68: twlogeye.TWLogEyeService.GetNotifyEscalation:input_type -> twlogeye.Empty
+	11, // 69: twlogeye.TWLogEyeService.RebuildLogIndex:input_type -> twlogeye.LogRequest
+	12, // 70: twlogeye.TWLogEyeService.GetLogStats:input_type -> twlogeye.LogStatsRequest
+	17, // 71: twlogeye.TWLogEyeService.GetDBUsage:input_type -> twlogeye.Empty
+	17, // 72: twlogeye.TWLogEyeService.GetDBStats:input_type -> twlogeye.Empty
+	17, // 73: twlogeye.TWLogEyeService.CompactDB:input_type -> twlogeye.Empty
+	47, // 74: twlogeye.TWLogEyeService.BackupDB:input_type -> twlogeye.BackupRequest
+	11, // 75: twlogeye.TWLogEyeService.GetArchiveList:input_type -> twlogeye.LogRequest
+	11, // 76: twlogeye.TWLogEyeService.ImportArchive:input_type -> twlogeye.LogRequest
+	11, // 77: twlogeye.TWLogEyeService.VerifyLog:input_type -> twlogeye.LogRequest
+	16, // 78: twlogeye.TWLogEyeService.Stop:output_type -> twlogeye.ControlResponse
+	16, // 79: twlogeye.TWLogEyeService.Reload:output_type -> twlogeye.ControlResponse
+	16, // 80: twlogeye.TWLogEyeService.ClearDB:output_type -> twlogeye.ControlResponse
+	1,  // 81: twlogeye.TWLogEyeService.WatchNotify:output_type -> twlogeye.NotifyResponse
+	1,  // 82: twlogeye.TWLogEyeService.SearchNotify:output_type -> twlogeye.NotifyResponse
+	15, // 83: twlogeye.TWLogEyeService.SearchLog:output_type -> twlogeye.LogResponse
+	21, // 84: twlogeye.TWLogEyeService.GetSyslogReport:output_type -> twlogeye.SyslogReportEnt
+	21, // 85: twlogeye.TWLogEyeService.GetLastSyslogReport:output_type -> twlogeye.SyslogReportEnt
+	23, // 86: twlogeye.TWLogEyeService.GetTrapReport:output_type -> twlogeye.TrapReportEnt
+	23, // 87: twlogeye.TWLogEyeService.GetLastTrapReport:output_type -> twlogeye.TrapReportEnt
+	31, // 88: twlogeye.TWLogEyeService.GetNetflowReport:output_type -> twlogeye.NetflowReportEnt
+	31, // 89: twlogeye.TWLogEyeService.GetLastNetflowReport:output_type -> twlogeye.NetflowReportEnt
+	33, // 90: twlogeye.TWLogEyeService.GetWindowsEventReport:output_type -> twlogeye.WindowsEventReportEnt
+	33, // 91: twlogeye.TWLogEyeService.GetLastWindowsEventReport:output_type -> twlogeye.WindowsEventReportEnt
+	35, // 92: twlogeye.TWLogEyeService.GetOTelReport:output_type -> twlogeye.OTelReportEnt
+	35, // 93: twlogeye.TWLogEyeService.GetLastOTelReport:output_type -> twlogeye.OTelReportEnt
+	37, // 94: twlogeye.TWLogEyeService.GetMqttReport:output_type -> twlogeye.MqttReportEnt
+	37, // 95: twlogeye.TWLogEyeService.GetLastMqttReport:output_type -> twlogeye.MqttReportEnt
+	39, // 96: twlogeye.TWLogEyeService.GetAnomalyReport:output_type -> twlogeye.AnomalyReportEnt
+	41, // 97: twlogeye.TWLogEyeService.GetLastAnomalyReport:output_type -> twlogeye.LastAnomalyReportEnt
+	42, // 98: twlogeye.TWLogEyeService.GetMonitorReport:output_type -> twlogeye.MonitorReportEnt
+	42, // 99: twlogeye.TWLogEyeService.GetLastMonitorReport:output_type -> twlogeye.MonitorReportEnt
+	54, // 100: twlogeye.TWLogEyeService.GetOTelMetricList:output_type -> twlogeye.OTelMetricListEnt
+	53, // 101: twlogeye.TWLogEyeService.GetOTelMetric:output_type -> twlogeye.OTelMetricEnt
+	57, // 102: twlogeye.TWLogEyeService.GetOTelTraceList:output_type -> twlogeye.OTelTraceListEnt
+	56, // 103: twlogeye.TWLogEyeService.GetOTelTrace:output_type -> twlogeye.OTelTraceEnt
+	9,  // 104: twlogeye.TWLogEyeService.GetNotifyOutbox:output_type -> twlogeye.NotifyOutboxEnt
+	16, // 105: twlogeye.TWLogEyeService.RedriveNotify:output_type -> twlogeye.ControlResponse
+	2,  // 106: twlogeye.TWLogEyeService.SearchIncident:output_type -> twlogeye.IncidentEnt
+	6,  // 107: twlogeye.TWLogEyeService.UpdateNotifyState:output_type -> twlogeye.NotifyWorkflowEnt
+	6,  // 108: twlogeye.TWLogEyeService.GetNotifyWorkflow:output_type -> twlogeye.NotifyWorkflowEnt
+	10, // 109: twlogeye.TWLogEyeService.GetNotifyEscalation:output_type -> twlogeye.NotifyEscalationEnt
+	16, // 110: twlogeye.TWLogEyeService.RebuildLogIndex:output_type -> twlogeye.ControlResponse
+	13, // 111: twlogeye.TWLogEyeService.GetLogStats:output_type -> twlogeye.LogStatsEnt
+	50, // 112: twlogeye.TWLogEyeService.GetDBUsage:output_type -> twlogeye.DBUsageEnt
+	49, // 113: twlogeye.TWLogEyeService.GetDBStats:output_type -> twlogeye.DBStatsResponse
+	43, // 114: twlogeye.TWLogEyeService.CompactDB:output_type -> twlogeye.DBMaintenanceEnt
+	48, // 115: twlogeye.TWLogEyeService.BackupDB:output_type -> twlogeye.BackupChunk
+	44, // 116: twlogeye.TWLogEyeService.GetArchiveList:output_type -> twlogeye.ArchiveEnt
+	16, // 117: twlogeye.TWLogEyeService.ImportArchive:output_type -> twlogeye.ControlResponse
+	45, // 118: twlogeye.TWLogEyeService.VerifyLog:output_type -> twlogeye.LogVerifyEnt
+	78, // [78:119] is the sub-list for method output_type
+	37, // [37:78] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_twlogeye_proto_init() }
@@ -3331,7 +6111,7 @@ func file_twlogeye_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_twlogeye_proto_rawDesc), len(file_twlogeye_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
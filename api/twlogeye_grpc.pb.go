@@ -45,6 +45,21 @@ const (
 	TWLogEyeService_GetOTelMetric_FullMethodName             = "/twlogeye.TWLogEyeService/GetOTelMetric"
 	TWLogEyeService_GetOTelTraceList_FullMethodName          = "/twlogeye.TWLogEyeService/GetOTelTraceList"
 	TWLogEyeService_GetOTelTrace_FullMethodName              = "/twlogeye.TWLogEyeService/GetOTelTrace"
+	TWLogEyeService_GetNotifyOutbox_FullMethodName           = "/twlogeye.TWLogEyeService/GetNotifyOutbox"
+	TWLogEyeService_RedriveNotify_FullMethodName             = "/twlogeye.TWLogEyeService/RedriveNotify"
+	TWLogEyeService_SearchIncident_FullMethodName            = "/twlogeye.TWLogEyeService/SearchIncident"
+	TWLogEyeService_UpdateNotifyState_FullMethodName         = "/twlogeye.TWLogEyeService/UpdateNotifyState"
+	TWLogEyeService_GetNotifyWorkflow_FullMethodName         = "/twlogeye.TWLogEyeService/GetNotifyWorkflow"
+	TWLogEyeService_GetNotifyEscalation_FullMethodName       = "/twlogeye.TWLogEyeService/GetNotifyEscalation"
+	TWLogEyeService_RebuildLogIndex_FullMethodName           = "/twlogeye.TWLogEyeService/RebuildLogIndex"
+	TWLogEyeService_GetLogStats_FullMethodName               = "/twlogeye.TWLogEyeService/GetLogStats"
+	TWLogEyeService_GetDBUsage_FullMethodName                = "/twlogeye.TWLogEyeService/GetDBUsage"
+	TWLogEyeService_GetDBStats_FullMethodName                = "/twlogeye.TWLogEyeService/GetDBStats"
+	TWLogEyeService_CompactDB_FullMethodName                 = "/twlogeye.TWLogEyeService/CompactDB"
+	TWLogEyeService_BackupDB_FullMethodName                  = "/twlogeye.TWLogEyeService/BackupDB"
+	TWLogEyeService_GetArchiveList_FullMethodName            = "/twlogeye.TWLogEyeService/GetArchiveList"
+	TWLogEyeService_ImportArchive_FullMethodName             = "/twlogeye.TWLogEyeService/ImportArchive"
+	TWLogEyeService_VerifyLog_FullMethodName                 = "/twlogeye.TWLogEyeService/VerifyLog"
 )
 
 // TWLogEyeServiceClient is the client API for TWLogEyeService service.
@@ -103,6 +118,36 @@ type TWLogEyeServiceClient interface {
 	GetOTelTraceList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OTelTraceListEnt], error)
 	// Get OpenTelemetry Trace
 	GetOTelTrace(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*OTelTraceEnt, error)
+	// Get notify deliveries in outbox and dead letter list
+	GetNotifyOutbox(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotifyOutboxEnt], error)
+	// Re-drive dead letter (empty id is all)
+	RedriveNotify(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	// Search incident
+	SearchIncident(ctx context.Context, in *NofifyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentEnt], error)
+	// Update state, assignee or comment of notify or incident
+	UpdateNotifyState(ctx context.Context, in *NotifyStateRequest, opts ...grpc.CallOption) (*NotifyWorkflowEnt, error)
+	// Get workflow (state, comments and history) of notify or incident
+	GetNotifyWorkflow(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*NotifyWorkflowEnt, error)
+	// Get pending escalations of unacknowledged notify
+	GetNotifyEscalation(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotifyEscalationEnt], error)
+	// Rebuild index of existing logs (logtype all is all types)
+	RebuildLogIndex(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	// Get count and stats of logs per group per time bucket
+	GetLogStats(ctx context.Context, in *LogStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogStatsEnt], error)
+	// Get estimated DB usage per log type and data type
+	GetDBUsage(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DBUsageEnt], error)
+	// Get LSM and value log size, usage and last maintenance result
+	GetDBStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DBStatsResponse, error)
+	// Compact LSM tree and run value log GC
+	CompactDB(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DBMaintenanceEnt, error)
+	// Online full or incremental backup of DB
+	BackupDB(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BackupChunk], error)
+	// Get archived log files
+	GetArchiveList(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveEnt], error)
+	// Import archived logs in time range to DB
+	ImportArchive(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	// Verify hash chain and checkpoints of logs
+	VerifyLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogVerifyEnt], error)
 }
 
 type tWLogEyeServiceClient struct {
@@ -490,6 +535,228 @@ func (c *tWLogEyeServiceClient) GetOTelTrace(ctx context.Context, in *IDRequest,
 	return out, nil
 }
 
+func (c *tWLogEyeServiceClient) GetNotifyOutbox(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotifyOutboxEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[13], TWLogEyeService_GetNotifyOutbox_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, NotifyOutboxEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetNotifyOutboxClient = grpc.ServerStreamingClient[NotifyOutboxEnt]
+
+func (c *tWLogEyeServiceClient) RedriveNotify(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, TWLogEyeService_RedriveNotify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) SearchIncident(ctx context.Context, in *NofifyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[14], TWLogEyeService_SearchIncident_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[NofifyRequest, IncidentEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_SearchIncidentClient = grpc.ServerStreamingClient[IncidentEnt]
+
+func (c *tWLogEyeServiceClient) UpdateNotifyState(ctx context.Context, in *NotifyStateRequest, opts ...grpc.CallOption) (*NotifyWorkflowEnt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyWorkflowEnt)
+	err := c.cc.Invoke(ctx, TWLogEyeService_UpdateNotifyState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) GetNotifyWorkflow(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*NotifyWorkflowEnt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyWorkflowEnt)
+	err := c.cc.Invoke(ctx, TWLogEyeService_GetNotifyWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) GetNotifyEscalation(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotifyEscalationEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[15], TWLogEyeService_GetNotifyEscalation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, NotifyEscalationEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetNotifyEscalationClient = grpc.ServerStreamingClient[NotifyEscalationEnt]
+
+func (c *tWLogEyeServiceClient) RebuildLogIndex(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, TWLogEyeService_RebuildLogIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) GetLogStats(ctx context.Context, in *LogStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogStatsEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[16], TWLogEyeService_GetLogStats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogStatsRequest, LogStatsEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetLogStatsClient = grpc.ServerStreamingClient[LogStatsEnt]
+
+func (c *tWLogEyeServiceClient) GetDBUsage(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DBUsageEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[17], TWLogEyeService_GetDBUsage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, DBUsageEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetDBUsageClient = grpc.ServerStreamingClient[DBUsageEnt]
+
+func (c *tWLogEyeServiceClient) GetDBStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DBStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DBStatsResponse)
+	err := c.cc.Invoke(ctx, TWLogEyeService_GetDBStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) CompactDB(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DBMaintenanceEnt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DBMaintenanceEnt)
+	err := c.cc.Invoke(ctx, TWLogEyeService_CompactDB_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) BackupDB(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BackupChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[18], TWLogEyeService_BackupDB_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BackupRequest, BackupChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_BackupDBClient = grpc.ServerStreamingClient[BackupChunk]
+
+func (c *tWLogEyeServiceClient) GetArchiveList(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[19], TWLogEyeService_GetArchiveList_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogRequest, ArchiveEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetArchiveListClient = grpc.ServerStreamingClient[ArchiveEnt]
+
+func (c *tWLogEyeServiceClient) ImportArchive(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, TWLogEyeService_ImportArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tWLogEyeServiceClient) VerifyLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogVerifyEnt], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TWLogEyeService_ServiceDesc.Streams[20], TWLogEyeService_VerifyLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogRequest, LogVerifyEnt]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_VerifyLogClient = grpc.ServerStreamingClient[LogVerifyEnt]
+
 // TWLogEyeServiceServer is the server API for TWLogEyeService service.
 // All implementations must embed UnimplementedTWLogEyeServiceServer
 // for forward compatibility.
@@ -546,6 +813,36 @@ type TWLogEyeServiceServer interface {
 	GetOTelTraceList(*Empty, grpc.ServerStreamingServer[OTelTraceListEnt]) error
 	// Get OpenTelemetry Trace
 	GetOTelTrace(context.Context, *IDRequest) (*OTelTraceEnt, error)
+	// Get notify deliveries in outbox and dead letter list
+	GetNotifyOutbox(*Empty, grpc.ServerStreamingServer[NotifyOutboxEnt]) error
+	// Re-drive dead letter (empty id is all)
+	RedriveNotify(context.Context, *IDRequest) (*ControlResponse, error)
+	// Search incident
+	SearchIncident(*NofifyRequest, grpc.ServerStreamingServer[IncidentEnt]) error
+	// Update state, assignee or comment of notify or incident
+	UpdateNotifyState(context.Context, *NotifyStateRequest) (*NotifyWorkflowEnt, error)
+	// Get workflow (state, comments and history) of notify or incident
+	GetNotifyWorkflow(context.Context, *IDRequest) (*NotifyWorkflowEnt, error)
+	// Get pending escalations of unacknowledged notify
+	GetNotifyEscalation(*Empty, grpc.ServerStreamingServer[NotifyEscalationEnt]) error
+	// Rebuild index of existing logs (logtype all is all types)
+	RebuildLogIndex(context.Context, *LogRequest) (*ControlResponse, error)
+	// Get count and stats of logs per group per time bucket
+	GetLogStats(*LogStatsRequest, grpc.ServerStreamingServer[LogStatsEnt]) error
+	// Get estimated DB usage per log type and data type
+	GetDBUsage(*Empty, grpc.ServerStreamingServer[DBUsageEnt]) error
+	// Get LSM and value log size, usage and last maintenance result
+	GetDBStats(context.Context, *Empty) (*DBStatsResponse, error)
+	// Compact LSM tree and run value log GC
+	CompactDB(context.Context, *Empty) (*DBMaintenanceEnt, error)
+	// Online full or incremental backup of DB
+	BackupDB(*BackupRequest, grpc.ServerStreamingServer[BackupChunk]) error
+	// Get archived log files
+	GetArchiveList(*LogRequest, grpc.ServerStreamingServer[ArchiveEnt]) error
+	// Import archived logs in time range to DB
+	ImportArchive(context.Context, *LogRequest) (*ControlResponse, error)
+	// Verify hash chain and checkpoints of logs
+	VerifyLog(*LogRequest, grpc.ServerStreamingServer[LogVerifyEnt]) error
 	mustEmbedUnimplementedTWLogEyeServiceServer()
 }
 
@@ -634,6 +931,51 @@ func (UnimplementedTWLogEyeServiceServer) GetOTelTraceList(*Empty, grpc.ServerSt
 func (UnimplementedTWLogEyeServiceServer) GetOTelTrace(context.Context, *IDRequest) (*OTelTraceEnt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOTelTrace not implemented")
 }
+func (UnimplementedTWLogEyeServiceServer) GetNotifyOutbox(*Empty, grpc.ServerStreamingServer[NotifyOutboxEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method GetNotifyOutbox not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) RedriveNotify(context.Context, *IDRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedriveNotify not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) SearchIncident(*NofifyRequest, grpc.ServerStreamingServer[IncidentEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchIncident not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) UpdateNotifyState(context.Context, *NotifyStateRequest) (*NotifyWorkflowEnt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotifyState not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) GetNotifyWorkflow(context.Context, *IDRequest) (*NotifyWorkflowEnt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifyWorkflow not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) GetNotifyEscalation(*Empty, grpc.ServerStreamingServer[NotifyEscalationEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method GetNotifyEscalation not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) RebuildLogIndex(context.Context, *LogRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildLogIndex not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) GetLogStats(*LogStatsRequest, grpc.ServerStreamingServer[LogStatsEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method GetLogStats not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) GetDBUsage(*Empty, grpc.ServerStreamingServer[DBUsageEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method GetDBUsage not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) GetDBStats(context.Context, *Empty) (*DBStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDBStats not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) CompactDB(context.Context, *Empty) (*DBMaintenanceEnt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactDB not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) BackupDB(*BackupRequest, grpc.ServerStreamingServer[BackupChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method BackupDB not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) GetArchiveList(*LogRequest, grpc.ServerStreamingServer[ArchiveEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method GetArchiveList not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) ImportArchive(context.Context, *LogRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportArchive not implemented")
+}
+func (UnimplementedTWLogEyeServiceServer) VerifyLog(*LogRequest, grpc.ServerStreamingServer[LogVerifyEnt]) error {
+	return status.Errorf(codes.Unimplemented, "method VerifyLog not implemented")
+}
 func (UnimplementedTWLogEyeServiceServer) mustEmbedUnimplementedTWLogEyeServiceServer() {}
 func (UnimplementedTWLogEyeServiceServer) testEmbeddedByValue()                         {}
 
@@ -1032,6 +1374,220 @@ func _TWLogEyeService_GetOTelTrace_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TWLogEyeService_GetNotifyOutbox_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).GetNotifyOutbox(m, &grpc.GenericServerStream[Empty, NotifyOutboxEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetNotifyOutboxServer = grpc.ServerStreamingServer[NotifyOutboxEnt]
+
+func _TWLogEyeService_RedriveNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).RedriveNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_RedriveNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).RedriveNotify(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_SearchIncident_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NofifyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).SearchIncident(m, &grpc.GenericServerStream[NofifyRequest, IncidentEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_SearchIncidentServer = grpc.ServerStreamingServer[IncidentEnt]
+
+func _TWLogEyeService_UpdateNotifyState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).UpdateNotifyState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_UpdateNotifyState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).UpdateNotifyState(ctx, req.(*NotifyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_GetNotifyWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).GetNotifyWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_GetNotifyWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).GetNotifyWorkflow(ctx, req.(*IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_GetNotifyEscalation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).GetNotifyEscalation(m, &grpc.GenericServerStream[Empty, NotifyEscalationEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetNotifyEscalationServer = grpc.ServerStreamingServer[NotifyEscalationEnt]
+
+func _TWLogEyeService_RebuildLogIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).RebuildLogIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_RebuildLogIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).RebuildLogIndex(ctx, req.(*LogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_GetLogStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).GetLogStats(m, &grpc.GenericServerStream[LogStatsRequest, LogStatsEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetLogStatsServer = grpc.ServerStreamingServer[LogStatsEnt]
+
+func _TWLogEyeService_GetDBUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).GetDBUsage(m, &grpc.GenericServerStream[Empty, DBUsageEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetDBUsageServer = grpc.ServerStreamingServer[DBUsageEnt]
+
+func _TWLogEyeService_GetDBStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).GetDBStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_GetDBStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).GetDBStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_CompactDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).CompactDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_CompactDB_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).CompactDB(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_BackupDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).BackupDB(m, &grpc.GenericServerStream[BackupRequest, BackupChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_BackupDBServer = grpc.ServerStreamingServer[BackupChunk]
+
+func _TWLogEyeService_GetArchiveList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).GetArchiveList(m, &grpc.GenericServerStream[LogRequest, ArchiveEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_GetArchiveListServer = grpc.ServerStreamingServer[ArchiveEnt]
+
+func _TWLogEyeService_ImportArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TWLogEyeServiceServer).ImportArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TWLogEyeService_ImportArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TWLogEyeServiceServer).ImportArchive(ctx, req.(*LogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TWLogEyeService_VerifyLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TWLogEyeServiceServer).VerifyLog(m, &grpc.GenericServerStream[LogRequest, LogVerifyEnt]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TWLogEyeService_VerifyLogServer = grpc.ServerStreamingServer[LogVerifyEnt]
+
 // TWLogEyeService_ServiceDesc is the grpc.ServiceDesc for TWLogEyeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1091,6 +1647,34 @@ var TWLogEyeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOTelTrace",
 			Handler:    _TWLogEyeService_GetOTelTrace_Handler,
 		},
+		{
+			MethodName: "RedriveNotify",
+			Handler:    _TWLogEyeService_RedriveNotify_Handler,
+		},
+		{
+			MethodName: "UpdateNotifyState",
+			Handler:    _TWLogEyeService_UpdateNotifyState_Handler,
+		},
+		{
+			MethodName: "GetNotifyWorkflow",
+			Handler:    _TWLogEyeService_GetNotifyWorkflow_Handler,
+		},
+		{
+			MethodName: "RebuildLogIndex",
+			Handler:    _TWLogEyeService_RebuildLogIndex_Handler,
+		},
+		{
+			MethodName: "GetDBStats",
+			Handler:    _TWLogEyeService_GetDBStats_Handler,
+		},
+		{
+			MethodName: "CompactDB",
+			Handler:    _TWLogEyeService_CompactDB_Handler,
+		},
+		{
+			MethodName: "ImportArchive",
+			Handler:    _TWLogEyeService_ImportArchive_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1158,6 +1742,46 @@ var TWLogEyeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _TWLogEyeService_GetOTelTraceList_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetNotifyOutbox",
+			Handler:       _TWLogEyeService_GetNotifyOutbox_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchIncident",
+			Handler:       _TWLogEyeService_SearchIncident_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetNotifyEscalation",
+			Handler:       _TWLogEyeService_GetNotifyEscalation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLogStats",
+			Handler:       _TWLogEyeService_GetLogStats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDBUsage",
+			Handler:       _TWLogEyeService_GetDBUsage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BackupDB",
+			Handler:       _TWLogEyeService_BackupDB_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetArchiveList",
+			Handler:       _TWLogEyeService_GetArchiveList_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "VerifyLog",
+			Handler:       _TWLogEyeService_VerifyLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "twlogeye.proto",
 }
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bradleyjkemp/sigma-go"
@@ -27,6 +28,9 @@ var auditorCh chan *datastore.LogEnt
 var reloadCh chan bool
 var watchChMap sync.Map
 
+// sigmaRuleMetaMap is immutable map of rule ID to metadata for notify goroutines.
+var sigmaRuleMetaMap atomic.Pointer[map[string]*notify.SigmaRuleMeta]
+
 func Init() bool {
 	loadSigmaConfigs()
 	loadSigmaRules()
@@ -34,33 +38,32 @@ func Init() bool {
 	loadNamedCaptures()
 	auditorCh = make(chan *datastore.LogEnt, 20000)
 	reloadCh = make(chan bool)
+	notify.SetSigmaRuleMetaFunc(getSigmaRuleMeta)
 	return len(evaluators) > 0
 }
 
 func Start(ctx context.Context, wg *sync.WaitGroup) {
 	log.Printf("start auditor")
-	defer wg.Done()
+	loadIncidents(time.Now())
+	timer := time.NewTicker(time.Second * 10)
+	defer func() {
+		timer.Stop()
+		wg.Done()
+	}()
 	for {
 		select {
 		case <-ctx.Done():
 			log.Printf("stop auditor")
 			return
+		case <-timer.C:
+			checkIncidents(time.Now())
 		case <-reloadCh:
 			evaluators = []*evaluator.RuleEvaluator{}
 			loadSigmaRules()
 		case l := <-auditorCh:
 			var n *datastore.NotifyEnt
 			if l.Type == datastore.AnomalyReport {
-				n = &datastore.NotifyEnt{
-					Time:  l.Time,
-					Src:   l.Src,
-					Type:  l.Type,
-					Log:   l.Log,
-					ID:    "TwLogEye:anomaly",
-					Level: "high",
-					Title: l.Log,
-					Tags:  "anomaly",
-				}
+				n = makeReporterNotify(l)
 			} else if ev := matchSigmaRule(l); ev != nil {
 				n = &datastore.NotifyEnt{
 					Time:  l.Time,
@@ -75,17 +78,49 @@ func Start(ctx context.Context, wg *sync.WaitGroup) {
 			} else {
 				continue
 			}
-			notify.Norify(n)
-			datastore.SaveNotify(n)
-			watchChMap.Range(func(k, v any) bool {
-				if ch, ok := v.(chan *datastore.NotifyEnt); ok {
-					ch <- n
-				}
-				return true
-			})
-			log.Printf("notify %s %s %s", n.Src, n.ID, n.Level)
+			if groupNotify(n, time.Now()) {
+				sendNotify(n)
+			}
+		}
+	}
+}
+
+// sendNotify saves notify and sends it to notify destinations and watchers.
+func sendNotify(n *datastore.NotifyEnt) {
+	// Save before notify to record delivery status
+	datastore.SaveNotify(n)
+	notify.Norify(n)
+	watchChMap.Range(func(k, v any) bool {
+		if ch, ok := v.(chan *datastore.NotifyEnt); ok {
+			ch <- n
+		}
+		return true
+	})
+	log.Printf("notify %s %s %s count=%d", n.Src, n.ID, n.Level, n.Count)
+}
+
+// makeReporterNotify makes notify from reporter detection.
+// Src is "<kind>:<detail>" like "anomaly:syslog", "scan:vertical" or "beacon:<src ip>".
+func makeReporterNotify(l *datastore.LogEnt) *datastore.NotifyEnt {
+	kind := "anomaly"
+	level := "high"
+	if a := strings.SplitN(l.Src, ":", 2); len(a) == 2 {
+		switch a[0] {
+		case "scan", "beacon", "bandwidth":
+			kind = a[0]
+			level = "medium"
 		}
 	}
+	return &datastore.NotifyEnt{
+		Time:  l.Time,
+		Src:   l.Src,
+		Type:  l.Type,
+		Log:   l.Log,
+		ID:    "TwLogEye:" + kind,
+		Level: level,
+		Title: l.Log,
+		Tags:  kind,
+	}
 }
 
 func Audit(l *datastore.LogEnt) {
@@ -177,6 +212,30 @@ func loadSigmaRules() {
 		}
 	})
 	log.Printf("load sigma rules total=%d skip=%d fix=%d dup=%d", total, skip, fix, dup)
+	setSigmaRuleMetaMap()
+}
+
+// setSigmaRuleMetaMap publishes metadata of loaded sigma rules.
+func setSigmaRuleMetaMap() {
+	m := make(map[string]*notify.SigmaRuleMeta)
+	for _, ev := range evaluators {
+		if _, ok := m[ev.ID]; ok {
+			continue
+		}
+		meta := &notify.SigmaRuleMeta{
+			Description: ev.Description,
+			Author:      ev.Author,
+			Status:      ev.Status,
+			References:  ev.References,
+		}
+		if fps, ok := ev.AdditionalFields["falsepositives"].([]interface{}); ok {
+			for _, fp := range fps {
+				meta.FalsePositives = append(meta.FalsePositives, fmt.Sprintf("%v", fp))
+			}
+		}
+		m[ev.ID] = meta
+	}
+	sigmaRuleMetaMap.Store(&m)
 }
 
 func loadSigmaConfigs() {
@@ -281,6 +340,16 @@ func GetSigmaRuleEvaluators() []*evaluator.RuleEvaluator {
 	return evaluators
 }
 
+// getSigmaRuleMeta returns metadata of sigma rule for notification.
+// It is called from notify goroutines, so it does not touch evaluators.
+func getSigmaRuleMeta(id string) *notify.SigmaRuleMeta {
+	m := sigmaRuleMetaMap.Load()
+	if m == nil {
+		return nil
+	}
+	return (*m)[id]
+}
+
 func GetEvaluators() []*evaluator.RuleEvaluator {
 	return evaluators
 }
@@ -0,0 +1,46 @@
+package auditor
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bradleyjkemp/sigma-go"
+	"github.com/bradleyjkemp/sigma-go/evaluator"
+)
+
+func TestSigmaRuleMeta(t *testing.T) {
+	defer func() {
+		evaluators = nil
+		sigmaRuleMetaMap.Store(nil)
+	}()
+	if m := getSigmaRuleMeta("rule1"); m != nil {
+		t.Errorf("meta before load %+v", m)
+	}
+	evaluators = []*evaluator.RuleEvaluator{evaluator.ForRule(sigma.Rule{
+		ID:               "rule1",
+		Description:      "test rule",
+		Author:           "twsnmp",
+		AdditionalFields: map[string]interface{}{"falsepositives": []interface{}{"admin"}},
+	})}
+	setSigmaRuleMetaMap()
+	// Reload rules while notify reads metadata
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			if m := getSigmaRuleMeta("rule1"); m == nil || m.Description != "test rule" || len(m.FalsePositives) != 1 {
+				t.Errorf("invalid meta %+v", m)
+				return
+			}
+		}
+	}()
+	for range 100 {
+		evaluators = append([]*evaluator.RuleEvaluator{}, evaluators[0])
+		setSigmaRuleMetaMap()
+	}
+	wg.Wait()
+	if m := getSigmaRuleMeta("unknown"); m != nil {
+		t.Errorf("unknown rule meta %+v", m)
+	}
+}
@@ -0,0 +1,145 @@
+package auditor
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// Repeated notifications with same key fields are folded into an incident.
+// Only the first occurrence and periodic updates are sent downstream.
+
+var incidentMap = make(map[string]*datastore.IncidentEnt)
+var incidentDirty = make(map[string]bool)
+
+var defaultIncidentKey = []string{"id", "src"}
+
+func makeIncidentKey(n *datastore.NotifyEnt) string {
+	keys := datastore.Config.IncidentKey
+	if len(keys) < 1 {
+		keys = defaultIncidentKey
+	}
+	vals := []string{}
+	for _, k := range keys {
+		switch strings.ToLower(strings.TrimSpace(k)) {
+		case "id":
+			vals = append(vals, n.ID)
+		case "src":
+			vals = append(vals, n.Src)
+		case "level":
+			vals = append(vals, n.Level)
+		case "type":
+			vals = append(vals, n.Type.String())
+		case "title":
+			vals = append(vals, n.Title)
+		case "tags":
+			vals = append(vals, n.Tags)
+		}
+	}
+	return strings.Join(vals, "\t")
+}
+
+func getIncidentWindow() time.Duration {
+	return time.Minute * time.Duration(datastore.Config.IncidentWindow)
+}
+
+// loadIncidents loads open incidents from DB.
+func loadIncidents(now time.Time) {
+	if datastore.Config.IncidentWindow < 1 {
+		return
+	}
+	datastore.ForEachIncident(0, 0, func(i *datastore.IncidentEnt) bool {
+		if !i.Closed && now.Sub(time.Unix(0, i.LastSeen)) < getIncidentWindow() {
+			incidentMap[i.Key] = i
+		}
+		return true
+	})
+	log.Printf("load %d open incidents", len(incidentMap))
+}
+
+// groupNotify folds notify into incident. It returns true when notify is sent downstream.
+func groupNotify(n *datastore.NotifyEnt, now time.Time) bool {
+	if datastore.Config.IncidentWindow < 1 {
+		return true
+	}
+	k := makeIncidentKey(n)
+	if i, ok := incidentMap[k]; ok {
+		if now.Sub(time.Unix(0, i.LastSeen)) < getIncidentWindow() {
+			i.Count++
+			i.LastSeen = n.Time
+			i.Notify = *n
+			incidentDirty[k] = true
+			return false
+		}
+		closeIncident(k, i, now)
+	}
+	t := n.Time
+	for datastore.GetIncident(datastore.NewIncidentID(t)) != nil {
+		t++
+	}
+	i := &datastore.IncidentEnt{
+		ID:            datastore.NewIncidentID(t),
+		Key:           k,
+		Notify:        *n,
+		Count:         1,
+		FirstSeen:     n.Time,
+		LastSeen:      n.Time,
+		NotifiedCount: 1,
+		LastNotified:  now.UnixNano(),
+	}
+	n.IncidentID = i.ID
+	n.Count = 1
+	n.FirstSeen = i.FirstSeen
+	n.LastSeen = i.LastSeen
+	datastore.SaveIncident(i)
+	incidentMap[k] = i
+	return true
+}
+
+// checkIncidents sends periodic updates and closes incidents after window.
+func checkIncidents(now time.Time) {
+	interval := time.Minute * time.Duration(datastore.Config.IncidentUpdateInterval)
+	for k, i := range incidentMap {
+		if now.Sub(time.Unix(0, i.LastSeen)) >= getIncidentWindow() {
+			closeIncident(k, i, now)
+			continue
+		}
+		if interval > 0 && i.Count > i.NotifiedCount && now.Sub(time.Unix(0, i.LastNotified)) >= interval {
+			sendIncidentUpdate(i, now)
+			delete(incidentDirty, k)
+			continue
+		}
+		if incidentDirty[k] {
+			datastore.SaveIncident(i)
+			delete(incidentDirty, k)
+		}
+	}
+}
+
+func closeIncident(k string, i *datastore.IncidentEnt, now time.Time) {
+	i.Closed = true
+	if i.Count > i.NotifiedCount {
+		// Final update
+		sendIncidentUpdate(i, now)
+	} else {
+		datastore.SaveIncident(i)
+	}
+	delete(incidentMap, k)
+	delete(incidentDirty, k)
+}
+
+func sendIncidentUpdate(i *datastore.IncidentEnt, now time.Time) {
+	n := i.Notify
+	n.Time = now.UnixNano()
+	n.IncidentID = i.ID
+	n.Count = i.Count
+	n.FirstSeen = i.FirstSeen
+	n.LastSeen = i.LastSeen
+	n.Delivery = nil
+	i.NotifiedCount = i.Count
+	i.LastNotified = n.Time
+	datastore.SaveIncident(i)
+	sendNotify(&n)
+}
@@ -0,0 +1,93 @@
+package auditor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+	"github.com/twsnmp/twlogeye/notify"
+)
+
+func TestIncident(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NotifyRetention = 1
+	datastore.Config.IncidentWindow = 10
+	datastore.Config.IncidentUpdateInterval = 5
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	notify.Init()
+	ch := AddWatch("test")
+	defer DelWatch("test")
+	now := time.Now()
+	getNotify := func(src string, t time.Time) *datastore.NotifyEnt {
+		return &datastore.NotifyEnt{
+			Time:  t.UnixNano(),
+			Type:  datastore.Syslog,
+			Src:   src,
+			ID:    "rule1",
+			Level: "high",
+			Title: "Login failed",
+		}
+	}
+	n := getNotify("192.168.1.1", now)
+	if !groupNotify(n, now) {
+		t.Fatal("first notify must be sent")
+	}
+	if n.IncidentID == "" || n.Count != 1 {
+		t.Errorf("invalid first notify %+v", n)
+	}
+	id := n.IncidentID
+	for i := 1; i < 6; i++ {
+		tm := now.Add(time.Second * time.Duration(i))
+		if groupNotify(getNotify("192.168.1.1", tm), tm) {
+			t.Errorf("repeated notify must be folded")
+		}
+	}
+	if !groupNotify(getNotify("192.168.1.2", now), now) {
+		t.Errorf("notify from other src must be sent")
+	}
+	checkIncidents(now.Add(time.Minute))
+	if len(ch) != 0 {
+		t.Errorf("update sent before interval")
+	}
+	if i := datastore.GetIncident(id); i == nil || i.Count != 6 || i.Closed {
+		t.Errorf("invalid incident %+v", i)
+	}
+	checkIncidents(now.Add(time.Minute * 6))
+	select {
+	case u := <-ch:
+		if u.IncidentID != id || u.Count != 6 || u.FirstSeen != now.UnixNano() || u.LastSeen != now.Add(time.Second*5).UnixNano() {
+			t.Errorf("invalid update %+v", u)
+		}
+	default:
+		t.Fatal("update not sent")
+	}
+	checkIncidents(now.Add(time.Minute * 7))
+	if len(ch) != 0 {
+		t.Errorf("update sent without new occurrence")
+	}
+	checkIncidents(now.Add(time.Minute * 20))
+	if len(incidentMap) != 0 || len(ch) != 0 {
+		t.Errorf("incidents not closed %d %d", len(incidentMap), len(ch))
+	}
+	if i := datastore.GetIncident(id); i == nil || !i.Closed {
+		t.Errorf("incident not closed %+v", i)
+	}
+	c := 0
+	datastore.ForEachIncident(0, 0, func(i *datastore.IncidentEnt) bool {
+		c++
+		return true
+	})
+	if c != 2 {
+		t.Errorf("invalid incident count %d", c)
+	}
+	tm := now.Add(time.Minute * 21)
+	if !groupNotify(getNotify("192.168.1.1", tm), tm) {
+		t.Errorf("notify after window must be sent")
+	}
+	// Disable
+	datastore.Config.IncidentWindow = 0
+	if !groupNotify(getNotify("192.168.1.1", tm), tm) {
+		t.Errorf("notify must be sent when grouping is disabled")
+	}
+}
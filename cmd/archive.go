@@ -0,0 +1,113 @@
+/*
+Copyright © 2025 Masayuki Yamai <twsnmp@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/twsnmp/twlogeye/api"
+)
+
+// archiveCmd represents the archive command
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Archived logs",
+	Long:  `List or import archived logs via api`,
+}
+
+var archiveListCmd = &cobra.Command{
+	Use:   "list [<logtype>]",
+	Short: "List archived log files",
+	Long: `List archived log files via api.
+logtype is syslog,trap,netflow,windows,otel,mqtt (default all).`,
+	Run: func(cmd *cobra.Command, args []string) {
+		t := ""
+		if len(args) > 0 {
+			t = args[0]
+		}
+		getArchiveList(t)
+	},
+}
+
+var archiveImportCmd = &cobra.Command{
+	Use:   "import <logtype>",
+	Short: "Import archived logs",
+	Long: `Import archived logs in time range to DB via api.
+logtype is syslog,trap,netflow,windows,otel,mqtt.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		importArchive(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(archiveCmd)
+	archiveCmd.AddCommand(archiveListCmd)
+	archiveCmd.AddCommand(archiveImportCmd)
+	archiveListCmd.Flags().StringVar(&startTime, "start", "", "start date and time")
+	archiveListCmd.Flags().StringVar(&endTime, "end", "", "end date and time")
+	archiveImportCmd.Flags().StringVar(&startTime, "start", "", "start date and time")
+	archiveImportCmd.Flags().StringVar(&endTime, "end", "", "end date and time")
+}
+
+func getArchiveList(t string) {
+	st := getTime(startTime, 0)
+	et := getTime(endTime, time.Now().UnixNano())
+	client := getClient()
+	s, err := client.GetArchiveList(context.Background(), &api.LogRequest{
+		Logtype: t,
+		Start:   st,
+		End:     et,
+	})
+	if err != nil {
+		log.Fatalf("get archive list err=%v", err)
+	}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("get archive list err=%v", err)
+		}
+		fmt.Printf("%s %s %s count=%d size=%d %s\n", r.GetType(), getReportTimeStr(r.GetStart()), getReportTimeStr(r.GetEnd()),
+			r.GetCount(), r.GetSize(), r.GetFile())
+	}
+}
+
+func importArchive(t string) {
+	if startTime == "" || endTime == "" {
+		log.Fatalln("archive import needs --start and --end")
+	}
+	st := getTime(startTime, 0)
+	et := getTime(endTime, time.Now().UnixNano())
+	client := getClient()
+	ret, err := client.ImportArchive(context.Background(), &api.LogRequest{
+		Logtype: t,
+		Start:   st,
+		End:     et,
+	})
+	if err != nil {
+		log.Fatalf("import archive err=%v", err)
+	}
+	fmt.Printf("import archive ret=%s\n", ret.String())
+}
@@ -0,0 +1,134 @@
+/*
+Copyright © 2025 Masayuki Yamai <twsnmp@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/twsnmp/twlogeye/api"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+var backupSince uint64
+var backupOnServer bool
+var restorePrefix string
+var restoreDBPath string
+
+var backupCmd = &cobra.Command{
+	Use:   "backup <file>",
+	Short: "Backup DB",
+	Long: `Online full or incremental backup of DB via api
+Use --since with the next since value of last backup for incremental backup
+Backup data is not encrypted even if DB is encrypted`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		backupDB(args[0])
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <file>...",
+	Short: "Restore DB",
+	Long: `Restore DB from backup files (full backup first, then incremental backups in order).
+twlogeye must be stopped. Full backup is restored only to empty DB.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		restoreDB(args)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	rootCmd.AddCommand(restoreCmd)
+	backupCmd.Flags().Uint64Var(&backupSince, "since", 0, "DB version for incremental backup 0=full")
+	backupCmd.Flags().BoolVar(&backupOnServer, "server", false, "Save backup file in backupDir on server")
+	restoreCmd.Flags().StringVarP(&restoreDBPath, "dbPath", "d", "", "DB Path default: dbPath of config")
+	restoreCmd.Flags().StringVar(&restorePrefix, "prefix", "", "restore only key prefixes (notify,sigma,...)")
+}
+
+func backupDB(path string) {
+	var w io.Writer
+	if !backupOnServer {
+		f, err := os.Create(path)
+		if err != nil {
+			log.Fatalf("backup err=%v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	req := &api.BackupRequest{Since: backupSince}
+	if backupOnServer {
+		req.Path = path
+	}
+	client := getClient()
+	s, err := client.BackupDB(context.Background(), req)
+	if err != nil {
+		log.Fatalf("backup err=%v", err)
+	}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("backup err=%v", err)
+		}
+		if len(r.GetData()) > 0 && w != nil {
+			if _, err := w.Write(r.GetData()); err != nil {
+				log.Fatalf("backup err=%v", err)
+			}
+		}
+		if r.GetManifest() != "" {
+			var m datastore.BackupManifestEnt
+			if err := json.Unmarshal([]byte(r.GetManifest()), &m); err != nil {
+				log.Fatalf("backup err=%v", err)
+			}
+			fmt.Printf("backup since=%d until=%d logs=%s-%s next since=%d\n", m.Since, m.Until,
+				getReportTimeStr(m.Start), getReportTimeStr(m.End), m.Until)
+		}
+	}
+}
+
+func restoreDB(files []string) {
+	if restoreDBPath != "" {
+		datastore.Config.DBPath = restoreDBPath
+	}
+	if datastore.Config.DBPath == "" {
+		log.Fatalln("restore needs dbPath")
+	}
+	prefixes := splitFlagList(restorePrefix)
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("restore err=%v", err)
+		}
+		r, err := datastore.RestoreDB(f, prefixes)
+		f.Close()
+		if err != nil {
+			log.Fatalf("restore %s err=%v", path, err)
+		}
+		fmt.Printf("restore %s since=%d until=%d keys=%d deleted=%d skipped=%d\n", path, r.Manifest.Since, r.Manifest.Until, r.Keys, r.Deleted, r.Skipped)
+	}
+}
@@ -46,10 +46,11 @@ var dashboardCmd = &cobra.Command{
   trap.count | trap.type 
   netflow.count | netflow.ip.packet | netflow.ip.byte | netflow.mac.packet | netflow.mac.byte 
   netflow.flow.packet | netflow.flow.byte | netflow.fumble | netflow.prot 
-  netflow.host | netflow.loc | netflow.country 
+  netflow.host | netflow.loc | netflow.country | netflow.if 
   winevent.count | winevent.pattern | winevent.error
   otel.count | otel.pattern | otel.error | otel.metric.<id>
   mqtt.count | mqtt.type 
+  notify.open
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		for _, p := range args {
@@ -89,9 +90,14 @@ var dashboardCmd = &cobra.Command{
 					dashboardMap[a[0]] = true
 					dashboardPanel = append(dashboardPanel, p)
 				}
+			case "notify":
+				if a[1] == "open" {
+					dashboardMap[a[0]] = true
+					dashboardPanel = append(dashboardPanel, p)
+				}
 			case "netflow":
 				switch a[1] {
-				case "count", "ip.packet", "ip.byte", "mac.packet", "mac.byte", "flow.packet", "flow.byte", "fumble", "prot", "host", "loc", "country":
+				case "count", "ip.packet", "ip.byte", "mac.packet", "mac.byte", "flow.packet", "flow.byte", "fumble", "prot", "host", "loc", "country", "if":
 					dashboardMap[a[0]] = true
 					dashboardPanel = append(dashboardPanel, p)
 				}
@@ -314,6 +320,9 @@ func checkDashboardReport() {
 			})
 		}
 	}
+	if _, ok := dashboardMap["notify"]; ok {
+		teaProg.Send(getOpenNotify(client))
+	}
 	mr, err := client.GetLastMonitorReport(context.Background(), &api.Empty{})
 	teaProg.Send(UpdateMonitorReportMsg{
 		err:    err,
@@ -361,6 +370,8 @@ type dashboardModel struct {
 	monitorReport      []*api.MonitorReportEnt
 	anomalyReport      *api.LastAnomalyReportEnt
 	anomalyScoreMap    map[string]*AnomalyScoreEnt
+	openNotify         []*api.NotifyResponse
+	openNotifyTime     int64
 }
 
 type UpdateSyslogReportMsg struct {
@@ -400,6 +411,11 @@ type UpdateOTelMetricMsg struct {
 	metric *api.OTelMetricEnt
 }
 
+type UpdateOpenNotifyMsg struct {
+	err  error
+	list []*api.NotifyResponse
+}
+
 type UpdateAnomalyReportMsg struct {
 	err             error
 	AnomalyScoreMap map[string]*AnomalyScoreEnt
@@ -529,6 +545,14 @@ func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.errMsg = msg.err.Error()
 		}
 		return m, nil
+	case UpdateOpenNotifyMsg:
+		if msg.err != nil {
+			m.errMsg = msg.err.Error()
+		} else {
+			m.openNotify = msg.list
+			m.openNotifyTime = time.Now().UnixNano()
+		}
+		return m, nil
 	case UpdateAnomalyReportMsg:
 		if msg.report != nil {
 			m.anomalyReport = msg.report
@@ -671,6 +695,8 @@ func (m dashboardModel) renderPanel(p string) string {
 		return m.renderNetflowLoc()
 	case "netflow.country":
 		return m.renderNetflowCountry()
+	case "netflow.if":
+		return m.renderNetflowIf()
 	case "winevent.count":
 		return m.renderWindowsEventCount()
 	case "winevent.pattern":
@@ -687,6 +713,8 @@ func (m dashboardModel) renderPanel(p string) string {
 		return m.renderMqttCount()
 	case "mqtt.type":
 		return m.renderMqttTypes()
+	case "notify.open":
+		return m.renderOpenNotify()
 	}
 	return "not implement"
 }
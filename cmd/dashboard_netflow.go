@@ -413,3 +413,81 @@ func (m dashboardModel) renderNetflowTopListContent(chartWidth int, patterns []*
 	}
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
+
+func (m dashboardModel) renderNetflowIf() string {
+	height := 8
+	width := (m.width / 2) - 2
+	if m.width < 80 {
+		width = m.width - 2
+	}
+	style := sectionStyle.Width(width).Height(height)
+	row := []string{}
+	if len(m.netflowReport) > 0 && len(m.netflowReport[len(m.netflowReport)-1].IfList) > 0 {
+		last := m.netflowReport[len(m.netflowReport)-1]
+		leftTitle := fmt.Sprintf("Netflow %d Interface", len(last.IfList))
+		rightTitle := "Cur/Avg/Max bps"
+		spacerWidth := width - 4 - len(leftTitle) - len(rightTitle)
+		headerText := titleStyle.Render(leftTitle)
+		if spacerWidth > 0 {
+			headerText = titleStyle.Render(leftTitle) + strings.Repeat(" ", spacerWidth) + lipgloss.NewStyle().Foreground(ColorGray).Render(rightTitle)
+		}
+		row = append(row, headerText)
+		for i, e := range last.IfList {
+			if i >= (height-1)/2 {
+				break
+			}
+			in := []float64{}
+			out := []float64{}
+			for _, r := range m.netflowReport {
+				var inBps, outBps float64
+				for _, ie := range r.IfList {
+					if ie.Exporter == e.Exporter && ie.IfIndex == e.IfIndex {
+						inBps = ie.InBps
+						outBps = ie.OutBps
+						break
+					}
+				}
+				in = append(in, inBps)
+				out = append(out, outBps)
+			}
+			name := e.Name
+			if name == "" {
+				name = fmt.Sprintf("%d", e.IfIndex)
+			}
+			inStyle := lipgloss.NewStyle().Foreground(ColorBlue)
+			if e.InUtil >= 80.0 {
+				inStyle = lipgloss.NewStyle().Foreground(ColorRed)
+			}
+			outStyle := lipgloss.NewStyle().Foreground(ColorGreen)
+			if e.OutUtil >= 80.0 {
+				outStyle = lipgloss.NewStyle().Foreground(ColorRed)
+			}
+			row = append(row, formatNetflowBpsLine(e.Exporter+" "+name+" In", in, width, inStyle))
+			row = append(row, formatNetflowBpsLine(e.Exporter+" "+name+" Out", out, width, outStyle))
+		}
+	} else {
+		row = append(row, titleStyle.Render("Netflow Interface"))
+		row = append(row, "No netflow interface report")
+	}
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, row...))
+}
+
+func formatNetflowBpsLine(l string, values []float64, width int, style lipgloss.Style) string {
+	c := values[len(values)-1]
+	max, _ := stats.Max(values)
+	avg, _ := stats.Mean(values)
+	value := fmt.Sprintf("%7s/%7s/%7s", humanize.SIWithDigits(c, 1, ""), humanize.SIWithDigits(avg, 1, ""), humanize.SIWithDigits(max, 1, ""))
+	if len(l) > 24 {
+		l = l[:24]
+	}
+	label := fmt.Sprintf(" %-24s", l)
+	text := style.Render(label + value)
+	sparklineWidth := width - lipgloss.Width(text) - 2
+	if sparklineWidth < 1 {
+		sparklineWidth = 1
+	}
+	sl := sparkline.New(sparklineWidth, 1, sparkline.WithStyle(style))
+	sl.PushAll(values)
+	sl.Draw()
+	return lipgloss.JoinHorizontal(lipgloss.Top, sl.View(), text)
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/twsnmp/twlogeye/api"
+)
+
+// getOpenNotify gets open notifications. Notifications in same incident are shown once.
+func getOpenNotify(client api.TWLogEyeServiceClient) UpdateOpenNotifyMsg {
+	ret := UpdateOpenNotifyMsg{}
+	s, err := client.SearchNotify(context.Background(), &api.NofifyRequest{
+		End:   time.Now().UnixNano(),
+		State: "open",
+	})
+	if err != nil {
+		ret.err = err
+		return ret
+	}
+	targetMap := make(map[string]int)
+	for {
+		r, err := s.Recv()
+		if err != nil {
+			if err != io.EOF {
+				ret.err = err
+			}
+			break
+		}
+		if i, ok := targetMap[r.GetTarget()]; ok {
+			// Keep latest
+			ret.list[i] = r
+			continue
+		}
+		targetMap[r.GetTarget()] = len(ret.list)
+		ret.list = append(ret.list, r)
+	}
+	sort.Slice(ret.list, func(i, j int) bool {
+		return ret.list[i].GetTime() > ret.list[j].GetTime()
+	})
+	return ret
+}
+
+func (m dashboardModel) renderOpenNotify() string {
+	height := 6
+	width := (m.width / 2) - 2
+	if m.width < 80 {
+		width = m.width - 2
+	}
+	style := sectionStyle.Width(width).Height(height)
+	row := []string{}
+	if m.openNotifyTime == 0 {
+		row = append(row, titleStyle.Render("Open Notify"))
+		row = append(row, "No notify data")
+		return style.Render(lipgloss.JoinVertical(lipgloss.Left, row...))
+	}
+	states := make(map[string]int)
+	for _, n := range m.openNotify {
+		states[n.GetState()]++
+	}
+	row = append(row, titleStyle.Render(fmt.Sprintf("Open Notify %d items new=%d ack=%d inv=%d",
+		len(m.openNotify), states["new"], states["acknowledged"], states["investigating"])))
+	row = append(row, m.renderOpenNotifyContent(width))
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, row...))
+}
+
+func (m dashboardModel) renderOpenNotifyContent(width int) string {
+	var lines []string
+	titleWidth := width - 40
+	if titleWidth < 20 {
+		titleWidth = 20
+	}
+	grayStyle := lipgloss.NewStyle().Foreground(ColorGray)
+	for i := 0; i < topNLines; i++ {
+		if i >= len(m.openNotify) {
+			lines = append(lines, grayStyle.Render("(no notify)"))
+			continue
+		}
+		n := m.openNotify[i]
+		title := n.GetTitle()
+		if n.GetCount() > 1 {
+			title = fmt.Sprintf("%s (x%d)", title, n.GetCount())
+		}
+		if len(title) > titleWidth {
+			title = title[:titleWidth-3] + "..."
+		}
+		state := n.GetState()
+		if n.GetAssignee() != "" {
+			state += "@" + n.GetAssignee()
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s │ %s",
+			grayStyle.Render(time.Unix(0, n.GetTime()).Format("01/02 15:04")),
+			getNotifyLevelStyle(n.GetLevel()).Render(fmt.Sprintf("%-8s", n.GetLevel())),
+			lipgloss.NewStyle().Foreground(ColorBlue).Render(fmt.Sprintf("%-13s", state)),
+			lipgloss.NewStyle().Foreground(ColorWhite).Render(title),
+		))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func getNotifyLevelStyle(l string) lipgloss.Style {
+	switch l {
+	case "critical":
+		return lipgloss.NewStyle().Foreground(ColorRed)
+	case "high":
+		return lipgloss.NewStyle().Foreground(ColorOrange)
+	case "medium":
+		return lipgloss.NewStyle().Foreground(ColorYellow)
+	}
+	return lipgloss.NewStyle().Foreground(ColorGray)
+}
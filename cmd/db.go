@@ -0,0 +1,169 @@
+/*
+Copyright © 2025 Masayuki Yamai <twsnmp@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/twsnmp/twlogeye/api"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// dbCmd represents the db command
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "DB maintenance",
+	Long:  `DB maintenance of twlogeye via api or local DB`,
+}
+
+var dbUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show DB usage",
+	Long:  `Show estimated DB usage per log type and data type via api`,
+	Run: func(cmd *cobra.Command, args []string) {
+		getDBUsage()
+	},
+}
+
+var dbStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show DB stats",
+	Long:  `Show LSM and value log size, usage and last GC result via api`,
+	Run: func(cmd *cobra.Command, args []string) {
+		getDBStats()
+	},
+}
+
+var dbCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Compact DB",
+	Long:  `Compact LSM tree and run value log GC via api`,
+	Run: func(cmd *cobra.Command, args []string) {
+		compactDB()
+	},
+}
+
+var rekeyDBPath string
+var rekeyKey datastore.DBKeySourceEnt
+var rekeyNewKey datastore.DBKeySourceEnt
+
+var dbRekeyCmd = &cobra.Command{
+	Use:   "rekey",
+	Short: "Change DB encryption key",
+	Long: `Change DB encryption key of local DB.
+twlogeye must be stopped. Current key default: DB encryption key of config`,
+	Run: func(cmd *cobra.Command, args []string) {
+		rekeyDB()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbUsageCmd)
+	dbCmd.AddCommand(dbStatsCmd)
+	dbCmd.AddCommand(dbCompactCmd)
+	dbCmd.AddCommand(dbRekeyCmd)
+	dbRekeyCmd.Flags().StringVarP(&rekeyDBPath, "dbPath", "d", "", "DB Path default: dbPath of config")
+	dbRekeyCmd.Flags().StringVar(&rekeyKey.File, "key", "", "current DB encryption key file")
+	dbRekeyCmd.Flags().StringVar(&rekeyKey.Env, "keyEnv", "", "Environment variable of current DB encryption key")
+	dbRekeyCmd.Flags().StringVar(&rekeyKey.Cmd, "keyCmd", "", "Command to print current DB encryption key")
+	dbRekeyCmd.Flags().StringVar(&rekeyNewKey.File, "newKey", "", "new DB encryption key file")
+	dbRekeyCmd.Flags().StringVar(&rekeyNewKey.Env, "newKeyEnv", "", "Environment variable of new DB encryption key")
+	dbRekeyCmd.Flags().StringVar(&rekeyNewKey.Cmd, "newKeyCmd", "", "Command to print new DB encryption key")
+}
+
+func getDBUsage() {
+	client := getClient()
+	s, err := client.GetDBUsage(context.Background(), &api.Empty{})
+	if err != nil {
+		log.Fatalf("get db usage err=%v", err)
+	}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("get db usage err=%v", err)
+		}
+		printDBUsage(r)
+	}
+}
+
+func printDBUsage(u *api.DBUsageEnt) {
+	if u.GetOldest() > 0 {
+		fmt.Printf("%s keys=%d size=%d oldest=%s newest=%s\n", u.GetType(), u.GetKeys(), u.GetSize(),
+			getReportTimeStr(u.GetOldest()), getReportTimeStr(u.GetNewest()))
+		return
+	}
+	fmt.Printf("%s keys=%d size=%d\n", u.GetType(), u.GetKeys(), u.GetSize())
+}
+
+func getDBStats() {
+	client := getClient()
+	r, err := client.GetDBStats(context.Background(), &api.Empty{})
+	if err != nil {
+		log.Fatalf("get db stats err=%v", err)
+	}
+	fmt.Printf("lsm=%d vlog=%d total=%d\n", r.GetLsmSize(), r.GetVlogSize(), r.GetLsmSize()+r.GetVlogSize())
+	if gc := r.GetLastGc(); gc != nil {
+		printDBMaintenance(gc)
+	}
+	for _, u := range r.GetUsage() {
+		printDBUsage(u)
+	}
+}
+
+func compactDB() {
+	client := getClient()
+	r, err := client.CompactDB(context.Background(), &api.Empty{})
+	if err != nil {
+		log.Fatalf("compact db err=%v", err)
+	}
+	printDBMaintenance(r)
+}
+
+func printDBMaintenance(r *api.DBMaintenanceEnt) {
+	fmt.Printf("%s gc flatten=%v count=%d before=%d after=%d dur=%v", getReportTimeStr(r.GetTime()), r.GetFlatten(), r.GetGcCount(),
+		r.GetBefore(), r.GetAfter(), time.Duration(r.GetDuration()))
+	if r.GetError() != "" {
+		fmt.Printf(" err=%s", r.GetError())
+	}
+	fmt.Println()
+}
+
+func rekeyDB() {
+	if rekeyDBPath != "" {
+		datastore.Config.DBPath = rekeyDBPath
+	}
+	if rekeyKey.File == "" && rekeyKey.Env == "" && rekeyKey.Cmd == "" {
+		rekeyKey = datastore.DBKeySourceEnt{
+			File: datastore.Config.DBEncryptionKey,
+			Env:  datastore.Config.DBEncryptionKeyEnv,
+			Cmd:  datastore.Config.DBEncryptionKeyCmd,
+		}
+	}
+	if err := datastore.RekeyDB(rekeyKey, rekeyNewKey); err != nil {
+		log.Fatalf("rekey db err=%v", err)
+	}
+	fmt.Printf("rekey db %s done\n", datastore.Config.DBPath)
+}
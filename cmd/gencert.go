@@ -23,12 +23,14 @@ import (
 )
 
 var cn string
+var integrityKey string
 
 // gencertCmd represents the gencert command
 var gencertCmd = &cobra.Command{
 	Use:   "gencert",
 	Short: "Generate TLS private key and cert",
-	Long:  `Generate TLS private key and cert for gRPC server/client`,
+	Long: `Generate TLS private key and cert for gRPC server/client
+and Ed25519 key to sign log checkpoints`,
 	Run: func(cmd *cobra.Command, args []string) {
 		hit := false
 		if apiServerCert != "" && apiServerKey != "" {
@@ -39,8 +41,12 @@ var gencertCmd = &cobra.Command{
 			datastore.GenClientCert(apiClientCert, apiClientKey, cn)
 			hit = true
 		}
+		if integrityKey != "" {
+			datastore.GenIntegrityKey(integrityKey)
+			hit = true
+		}
 		if !hit {
-			log.Fatalln("please set server or client cert")
+			log.Fatalln("please set server or client cert or integrity key")
 		}
 	},
 }
@@ -48,4 +54,5 @@ var gencertCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(gencertCmd)
 	gencertCmd.Flags().StringVar(&cn, "cn", "twsnmp", "CN for client cert")
+	gencertCmd.Flags().StringVar(&integrityKey, "integrityKey", "", "Ed25519 key file to sign log checkpoints")
 }
@@ -21,14 +21,24 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
 	"time"
 
+	"github.com/NimbleMarkets/ntcharts/sparkline"
 	"github.com/spf13/cobra"
 	"github.com/twsnmp/twlogeye/api"
 )
 
 var logtype string
 var search string
+var query string
+var groupBy string
+var statsInterval string
+var statsFields string
+var sparklineMode bool
 
 // logCmd represents the log command
 var logCmd = &cobra.Command{
@@ -40,12 +50,47 @@ var logCmd = &cobra.Command{
 	},
 }
 
+var logReindexCmd = &cobra.Command{
+	Use:   "reindex [<logtype>]",
+	Short: "Rebuild log index",
+	Long: `Rebuild index of existing logs via api.
+logtype is syslog,trap,netflow,windows,otel,mqtt or all (default all).`,
+	Run: func(cmd *cobra.Command, args []string) {
+		t := "all"
+		if len(args) > 0 {
+			t = args[0]
+		}
+		rebuildLogIndex(t)
+	},
+}
+
+var logStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Get log stats",
+	Long: `Get count and sum,avg,min,max of numeric fields of logs
+per group per time bucket via api.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		getLogStats()
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(logCmd)
+	logCmd.AddCommand(logReindexCmd)
+	logCmd.AddCommand(logStatsCmd)
+	logStatsCmd.Flags().StringVar(&logtype, "logtype", "syslog", "log type ")
+	logStatsCmd.Flags().StringVar(&startTime, "start", "", "start date and time")
+	logStatsCmd.Flags().StringVar(&endTime, "end", "", "end date and time")
+	logStatsCmd.Flags().StringVar(&query, "query", "", "search query (severity<=3 AND hostname:fw* AND NOT tag:cron)")
+	logStatsCmd.Flags().StringVar(&groupBy, "groupBy", "", "group by fields (hostname,tag,src)")
+	logStatsCmd.Flags().StringVar(&statsInterval, "interval", "", "time bucket like 1h (empty is whole time range)")
+	logStatsCmd.Flags().StringVar(&statsFields, "fields", "", "numeric fields for sum,avg,min,max (bytes,packets)")
+	logStatsCmd.Flags().BoolVar(&sparklineMode, "sparkline", false, "show count per group as sparkline")
 	logCmd.Flags().StringVar(&logtype, "logtype", "syslog", "log type ")
 	logCmd.Flags().StringVar(&startTime, "start", "", "start date and time")
 	logCmd.Flags().StringVar(&endTime, "end", "", "end date and time")
 	logCmd.Flags().StringVar(&search, "search", "", "search text")
+	logCmd.Flags().StringVar(&query, "query", "", "search query (severity<=3 AND hostname:fw* AND NOT tag:cron)")
 }
 
 func searchLog() {
@@ -57,6 +102,7 @@ func searchLog() {
 		Start:   st,
 		End:     et,
 		Search:  search,
+		Query:   query,
 	})
 	if err != nil {
 		log.Fatalf("search log err=%v", err)
@@ -72,3 +118,130 @@ func searchLog() {
 		fmt.Printf("%s %s %s\n", getTimeStr(r.GetTime()), r.GetSrc(), r.GetLog())
 	}
 }
+
+func rebuildLogIndex(t string) {
+	client := getClient()
+	ret, err := client.RebuildLogIndex(context.Background(), &api.LogRequest{Logtype: t})
+	if err != nil {
+		log.Fatalf("rebuild log index err=%v", err)
+	}
+	fmt.Printf("rebuild log index ret=%s\n", ret.String())
+}
+
+func getLogStats() {
+	st := getTime(startTime, 0)
+	et := getTime(endTime, time.Now().UnixNano())
+	var interval time.Duration
+	if statsInterval != "" {
+		var err error
+		if interval, err = time.ParseDuration(statsInterval); err != nil || interval < time.Second {
+			log.Fatalf("invalid interval %s", statsInterval)
+		}
+	}
+	if sparklineMode && interval == 0 {
+		log.Fatalln("sparkline needs interval")
+	}
+	group := splitFlagList(groupBy)
+	fields := splitFlagList(statsFields)
+	client := getClient()
+	s, err := client.GetLogStats(context.Background(), &api.LogStatsRequest{
+		Logtype:  logtype,
+		Start:    st,
+		End:      et,
+		Query:    query,
+		GroupBy:  group,
+		Interval: int64(interval / time.Second),
+		Fields:   fields,
+	})
+	if err != nil {
+		log.Fatalf("get log stats err=%v", err)
+	}
+	list := []*api.LogStatsEnt{}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("get log stats err=%v", err)
+		}
+		list = append(list, r)
+	}
+	if sparklineMode {
+		printLogStatsSparkline(list, group, interval)
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	h := []string{"Time"}
+	h = append(h, group...)
+	h = append(h, "Count")
+	for _, f := range fields {
+		h = append(h, f+".sum", f+".avg", f+".min", f+".max")
+	}
+	fmt.Fprintln(w, strings.Join(h, "\t"))
+	for _, r := range list {
+		row := []string{getReportTimeStr(r.GetTime())}
+		row = append(row, r.GetGroup()...)
+		row = append(row, fmt.Sprintf("%d", r.GetCount()))
+		for _, f := range r.GetStats() {
+			row = append(row, fmt.Sprintf("%g", f.GetSum()), fmt.Sprintf("%.2f", f.GetAvg()), fmt.Sprintf("%g", f.GetMin()), fmt.Sprintf("%g", f.GetMax()))
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+}
+
+// printLogStatsSparkline shows count per group per time bucket as sparkline.
+func printLogStatsSparkline(list []*api.LogStatsEnt, group []string, interval time.Duration) {
+	if len(list) < 1 {
+		return
+	}
+	iv := int64(interval)
+	first := list[0].GetTime()
+	last := first
+	for _, r := range list {
+		first = min(first, r.GetTime())
+		last = max(last, r.GetTime())
+	}
+	n := int((last-first)/iv) + 1
+	keys := []string{}
+	values := make(map[string][]float64)
+	for _, r := range list {
+		k := strings.Join(r.GetGroup(), " ")
+		if len(group) < 1 {
+			k = "all"
+		}
+		if _, ok := values[k]; !ok {
+			keys = append(keys, k)
+			values[k] = make([]float64, n)
+		}
+		values[k][(r.GetTime()-first)/iv] += float64(r.GetCount())
+	}
+	sort.Strings(keys)
+	fmt.Printf("%s - %s interval=%s\n", getReportTimeStr(first), getReportTimeStr(last+iv), interval)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	for _, k := range keys {
+		v := values[k]
+		total := 0.0
+		peak := 0.0
+		for _, c := range v {
+			total += c
+			peak = max(peak, c)
+		}
+		sl := sparkline.New(min(len(v), 120), 1)
+		sl.PushAll(v)
+		sl.Draw()
+		fmt.Fprintf(w, "%s\t%s\ttotal=%d\tmax=%d\n", k, sl.View(), int64(total), int64(peak))
+	}
+	w.Flush()
+}
+
+func splitFlagList(s string) []string {
+	ret := []string{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
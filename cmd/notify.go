@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -30,6 +32,11 @@ import (
 var level string
 var startTime string
 var endTime string
+var notifyState string
+var notifyAssignee string
+var notifyComment string
+var notifyUser string
+var notifyFalsePositive bool
 
 // notifyCmd represents the notify command
 var notifyCmd = &cobra.Command{
@@ -41,11 +48,127 @@ var notifyCmd = &cobra.Command{
 	},
 }
 
+var notifyAckCmd = &cobra.Command{
+	Use:   "ack <target>",
+	Short: "Acknowledge notify",
+	Long:  `Acknowledge notify or incident via api. <target> is shown in search result.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		updateNotifyState(args[0], "acknowledged")
+	},
+}
+
+var notifyInvestigateCmd = &cobra.Command{
+	Use:   "investigate <target>",
+	Short: "Start investigating notify",
+	Long:  `Set investigating state to notify or incident via api.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		updateNotifyState(args[0], "investigating")
+	},
+}
+
+var notifyResolveCmd = &cobra.Command{
+	Use:   "resolve <target>",
+	Short: "Resolve notify",
+	Long:  `Resolve notify or incident via api. Use --falsePositive for false positive.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		state := "resolved"
+		if notifyFalsePositive {
+			state = "false-positive"
+		}
+		updateNotifyState(args[0], state)
+	},
+}
+
+var notifyCommentCmd = &cobra.Command{
+	Use:   "comment <target> <comment>",
+	Short: "Add comment to notify",
+	Long:  `Add comment to notify or incident via api.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		notifyComment = args[1]
+		updateNotifyState(args[0], "")
+	},
+}
+
+var notifyEscalationCmd = &cobra.Command{
+	Use:   "escalation",
+	Short: "Show notify escalation",
+	Long:  `Show pending escalations of unacknowledged notify via api.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		getNotifyEscalation()
+	},
+}
+
+var notifyHistoryCmd = &cobra.Command{
+	Use:   "history <target>",
+	Short: "Show notify history",
+	Long:  `Show state, assignee, comments and history of notify or incident via api.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		getNotifyWorkflow(args[0])
+	},
+}
+
+var notifyIncidentCmd = &cobra.Command{
+	Use:   "incident",
+	Short: "Search incident",
+	Long:  `Search incidents (groups of repeated notify) via api`,
+	Run: func(cmd *cobra.Command, args []string) {
+		searchIncident()
+	},
+}
+
+var notifyOutboxCmd = &cobra.Command{
+	Use:   "outbox",
+	Short: "Show notify outbox",
+	Long:  `Show pending and dead letter notify deliveries via api`,
+	Run: func(cmd *cobra.Command, args []string) {
+		getNotifyOutbox()
+	},
+}
+
+var notifyRedriveCmd = &cobra.Command{
+	Use:   "redrive [<key>]",
+	Short: "Re-drive dead letter",
+	Long:  `Re-drive dead letter notify deliveries via api. Without key, all dead letters are re-driven.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		key := ""
+		if len(args) > 0 {
+			key = args[0]
+		}
+		redriveNotify(key)
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(notifyCmd)
-	notifyCmd.Flags().StringVar(&level, "level", "", "notify level")
-	notifyCmd.Flags().StringVar(&startTime, "start", "", "start date and time")
-	notifyCmd.Flags().StringVar(&endTime, "end", "", "end date and time")
+	notifyCmd.AddCommand(notifyIncidentCmd)
+	notifyCmd.AddCommand(notifyAckCmd)
+	notifyCmd.AddCommand(notifyInvestigateCmd)
+	notifyCmd.AddCommand(notifyResolveCmd)
+	notifyCmd.AddCommand(notifyCommentCmd)
+	notifyCmd.AddCommand(notifyHistoryCmd)
+	notifyCmd.AddCommand(notifyEscalationCmd)
+	notifyCmd.AddCommand(notifyOutboxCmd)
+	notifyCmd.AddCommand(notifyRedriveCmd)
+	notifyCmd.PersistentFlags().StringVar(&level, "level", "", "notify level")
+	notifyCmd.PersistentFlags().StringVar(&startTime, "start", "", "start date and time")
+	notifyCmd.PersistentFlags().StringVar(&endTime, "end", "", "end date and time")
+	notifyCmd.Flags().StringVar(&notifyState, "state", "", "notify state (new,acknowledged,investigating,resolved,false-positive,open)")
+	notifyCmd.Flags().StringVar(&notifyAssignee, "assignee", "", "notify assignee")
+	notifyIncidentCmd.Flags().StringVar(&notifyState, "state", "", "incident state (new,acknowledged,investigating,resolved,false-positive,open)")
+	notifyIncidentCmd.Flags().StringVar(&notifyAssignee, "assignee", "", "incident assignee")
+	for _, c := range []*cobra.Command{notifyAckCmd, notifyInvestigateCmd, notifyResolveCmd, notifyCommentCmd} {
+		c.Flags().StringVar(&notifyUser, "user", os.Getenv("USER"), "user name")
+	}
+	for _, c := range []*cobra.Command{notifyAckCmd, notifyInvestigateCmd, notifyResolveCmd} {
+		c.Flags().StringVar(&notifyAssignee, "assignee", "", "assignee (- is unassign)")
+		c.Flags().StringVar(&notifyComment, "comment", "", "comment")
+	}
+	notifyResolveCmd.Flags().BoolVar(&notifyFalsePositive, "falsePositive", false, "resolve as false positive")
 }
 
 func searchNotify() {
@@ -53,9 +176,11 @@ func searchNotify() {
 	et := getTime(endTime, time.Now().UnixNano())
 	client := getClient()
 	s, err := client.SearchNotify(context.Background(), &api.NofifyRequest{
-		Start: st,
-		End:   et,
-		Level: level,
+		Start:    st,
+		End:      et,
+		Level:    level,
+		State:    notifyState,
+		Assignee: notifyAssignee,
 	})
 	if err != nil {
 		log.Fatalf("search notify err=%v", err)
@@ -69,5 +194,143 @@ func searchNotify() {
 			log.Fatalf("search notify err=%v", err)
 		}
 		fmt.Printf("---\n%s %s %s %s\n%s\n%s\n", getTimeStr(r.GetTime()), r.GetSrc(), r.GetLevel(), r.GetId(), r.GetTags(), r.GetTitle())
+		fmt.Printf("target=%s state=%s assignee=%s\n", r.GetTarget(), r.GetState(), r.GetAssignee())
+		if r.GetCount() > 1 {
+			fmt.Printf("incident=%s count=%d first=%s last=%s\n", r.GetIncidentId(), r.GetCount(), getTimeStr(r.GetFirstSeen()), getTimeStr(r.GetLastSeen()))
+		}
+		for _, d := range r.GetDelivery() {
+			dst := d.GetDst()
+			if d.GetEscalation() > 0 {
+				dst = fmt.Sprintf("%s escalation=%d", dst, d.GetEscalation())
+			}
+			fmt.Printf("%s %s attempts=%d %s %s\n", dst, d.GetStatus(), d.GetAttempts(), getTimeStr(d.GetTime()), d.GetError())
+		}
+		for _, a := range r.GetActions() {
+			fmt.Printf("action %s %s %s code=%d %dms %s\n%s\n", a.GetName(), a.GetType(), a.GetStatus(), a.GetCode(), a.GetDuration(), a.GetError(), strings.TrimSpace(a.GetOutput()))
+		}
+	}
+}
+
+func searchIncident() {
+	st := getTime(startTime, 0)
+	et := getTime(endTime, time.Now().UnixNano())
+	client := getClient()
+	s, err := client.SearchIncident(context.Background(), &api.NofifyRequest{
+		Start:    st,
+		End:      et,
+		Level:    level,
+		State:    notifyState,
+		Assignee: notifyAssignee,
+	})
+	if err != nil {
+		log.Fatalf("search incident err=%v", err)
+	}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("search incident err=%v", err)
+		}
+		status := "open"
+		if r.GetClosed() {
+			status = "closed"
+		}
+		fmt.Printf("---\nincident:%s %s count=%d first=%s last=%s\n%s %s %s\n%s\n%s\nstate=%s assignee=%s\n", r.GetId(), status, r.GetCount(),
+			getTimeStr(r.GetFirstSeen()), getTimeStr(r.GetLastSeen()), r.GetSrc(), r.GetLevel(), r.GetRuleId(), r.GetTags(), r.GetTitle(),
+			r.GetState(), r.GetAssignee())
+	}
+}
+
+func updateNotifyState(target, state string) {
+	client := getClient()
+	w, err := client.UpdateNotifyState(context.Background(), &api.NotifyStateRequest{
+		Target:   target,
+		User:     notifyUser,
+		State:    state,
+		Assignee: notifyAssignee,
+		Comment:  notifyComment,
+	})
+	if err != nil {
+		log.Fatalf("update notify err=%v", err)
+	}
+	fmt.Printf("%s state=%s assignee=%s\n", w.GetTarget(), w.GetState(), w.GetAssignee())
+}
+
+func getNotifyWorkflow(target string) {
+	client := getClient()
+	w, err := client.GetNotifyWorkflow(context.Background(), &api.IDRequest{Id: target})
+	if err != nil {
+		log.Fatalf("get notify history err=%v", err)
+	}
+	fmt.Printf("%s state=%s assignee=%s\n", w.GetTarget(), w.GetState(), w.GetAssignee())
+	for _, h := range w.GetHistory() {
+		s := []string{}
+		if h.GetState() != "" {
+			s = append(s, "state="+h.GetState())
+		}
+		if h.GetAssignee() != "" {
+			s = append(s, "assignee="+h.GetAssignee())
+		}
+		if h.GetComment() != "" {
+			s = append(s, "comment="+h.GetComment())
+		}
+		fmt.Printf("%s %s %s\n", getTimeStr(h.GetTime()), h.GetUser(), strings.Join(s, " "))
+	}
+}
+
+func getNotifyOutbox() {
+	client := getClient()
+	s, err := client.GetNotifyOutbox(context.Background(), &api.Empty{})
+	if err != nil {
+		log.Fatalf("get notify outbox err=%v", err)
+	}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("get notify outbox err=%v", err)
+		}
+		status := "pending"
+		next := getTimeStr(r.GetNextTry())
+		if r.GetDead() {
+			status = "dead"
+			next = "-"
+		}
+		fmt.Printf("---\n%s %s %s attempts=%d next=%s\n%s %s %s %s\n%s\n",
+			r.GetKey(), r.GetDst(), status, r.GetAttempts(), next,
+			getTimeStr(r.GetNotifyTime()), r.GetSrc(), r.GetLevel(), r.GetId(), r.GetLastError())
+	}
+}
+
+func getNotifyEscalation() {
+	client := getClient()
+	s, err := client.GetNotifyEscalation(context.Background(), &api.Empty{})
+	if err != nil {
+		log.Fatalf("get notify escalation err=%v", err)
+	}
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("get notify escalation err=%v", err)
+		}
+		fmt.Printf("---\n%s policy=%s step=%d next=%s dst=%s\n%s %s %s %s\n%s\n",
+			r.GetTarget(), r.GetPolicy(), r.GetStep(), getTimeStr(r.GetNext()), strings.Join(r.GetDst(), ","),
+			getTimeStr(r.GetCreated()), r.GetSrc(), r.GetLevel(), r.GetRuleId(), r.GetTitle())
+	}
+}
+
+func redriveNotify(key string) {
+	client := getClient()
+	ret, err := client.RedriveNotify(context.Background(), &api.IDRequest{Id: key})
+	if err != nil {
+		log.Fatalf("redrive notify err=%v", err)
 	}
+	fmt.Printf("redrive ret=%s\n", ret.String())
 }
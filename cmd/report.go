@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -257,6 +258,26 @@ func getNetflowReport(st, et int64) {
 			for i, t := range r.GetTopFumbleSrcList() {
 				fmt.Printf("%d\t%s\t%d\n", i+1, t.GetKey(), t.GetCount())
 			}
+			fmt.Println("Zone matrix")
+			fmt.Println("No.\tSrc zone\tDst zone\tPackets\tBytes\tFlows")
+			for i, t := range r.GetZoneMatrix() {
+				fmt.Printf("%d\t%s\t%s\t%d\t%d\t%d\n", i+1, t.GetSrc(), t.GetDst(), t.GetPackets(), t.GetBytes(), t.GetFlows())
+			}
+			printNetflowIfList(r.GetIfList())
+			if len(r.GetScanList()) > 0 {
+				fmt.Printf("Scan list scans=%d\n", r.GetScans())
+				fmt.Println("No.\tTime\tType\tSrc\tDst\tPorts\tCount\tRate\tTargets")
+				for i, t := range r.GetScanList() {
+					fmt.Printf("%d\t%s\t%s\t%s\t%s\t%s\t%d\t%.2f\t%s\n", i+1, getReportTimeStr(t.GetTime()), t.GetType(), t.GetSrc(), t.GetDst(), t.GetPortRange(), t.GetCount(), t.GetRate(), strings.Join(t.GetTargets(), ","))
+				}
+			}
+			if len(r.GetBeaconList()) > 0 {
+				fmt.Printf("Beacon list beacons=%d\n", r.GetBeacons())
+				fmt.Println("No.\tLast\tSrc\tDst\tPort\tCount\tInterval\tJitter\tScore")
+				for i, t := range r.GetBeaconList() {
+					fmt.Printf("%d\t%s\t%s\t%s\t%d\t%d\t%.1f\t%.1f\t%.2f\n", i+1, getReportTimeStr(t.GetTime()), t.GetSrc(), t.GetDst(), t.GetPort(), t.GetCount(), t.GetInterval(), t.GetJitter(), t.GetScore())
+				}
+			}
 			fmt.Println("===")
 		}
 	}
@@ -312,10 +333,49 @@ func getLastNetflowReport() {
 		for i, t := range r.GetTopFumbleSrcList() {
 			fmt.Printf("%d\t%s\t%d\n", i+1, t.GetKey(), t.GetCount())
 		}
+		if len(r.GetScanList()) > 0 {
+			fmt.Printf("Scan list scans=%d\n", r.GetScans())
+			fmt.Println("No.\tTime\tType\tSrc\tDst\tPorts\tCount\tRate\tTargets")
+			for i, t := range r.GetScanList() {
+				fmt.Printf("%d\t%s\t%s\t%s\t%s\t%s\t%d\t%.2f\t%s\n", i+1, getReportTimeStr(t.GetTime()), t.GetType(), t.GetSrc(), t.GetDst(), t.GetPortRange(), t.GetCount(), t.GetRate(), strings.Join(t.GetTargets(), ","))
+			}
+		}
+		if len(r.GetBeaconList()) > 0 {
+			fmt.Printf("Beacon list beacons=%d\n", r.GetBeacons())
+			fmt.Println("No.\tLast\tSrc\tDst\tPort\tCount\tInterval\tJitter\tScore")
+			for i, t := range r.GetBeaconList() {
+				fmt.Printf("%d\t%s\t%s\t%s\t%d\t%d\t%.1f\t%.1f\t%.2f\n", i+1, getReportTimeStr(t.GetTime()), t.GetSrc(), t.GetDst(), t.GetPort(), t.GetCount(), t.GetInterval(), t.GetJitter(), t.GetScore())
+			}
+		}
+		fmt.Println("Zone matrix")
+		fmt.Println("No.\tSrc zone\tDst zone\tPackets\tBytes\tFlows")
+		for i, t := range r.GetZoneMatrix() {
+			fmt.Printf("%d\t%s\t%s\t%d\t%d\t%d\n", i+1, t.GetSrc(), t.GetDst(), t.GetPackets(), t.GetBytes(), t.GetFlows())
+		}
+		printNetflowIfList(r.GetIfList())
 		fmt.Println("===")
 	}
 }
 
+func printNetflowIfList(list []*api.NetflowIfEnt) {
+	if len(list) < 1 {
+		return
+	}
+	fmt.Println("Interface list")
+	fmt.Println("No.\tExporter\tIndex\tName\tIn bps\tOut bps\tIn pps\tOut pps\tIn util\tOut util\tTop talkers")
+	for i, t := range list {
+		talkers := ""
+		for j, tt := range t.GetTopTalkerList() {
+			if j > 0 {
+				talkers += ","
+			}
+			talkers += tt.GetKey()
+		}
+		fmt.Printf("%d\t%s\t%d\t%s\t%.0f\t%.0f\t%.1f\t%.1f\t%.1f%%\t%.1f%%\t%s\n", i+1, t.GetExporter(), t.GetIfIndex(), t.GetName(),
+			t.GetInBps(), t.GetOutBps(), t.GetInPps(), t.GetOutPps(), t.GetInUtil(), t.GetOutUtil(), talkers)
+	}
+}
+
 func getWindowsEventReport(st, et int64) {
 	client := getClient()
 	s, err := client.GetWindowsEventReport(context.Background(), &api.ReportRequest{Start: st, End: et})
@@ -534,4 +594,11 @@ func getLastMonitorReport() {
 	}
 	fmt.Printf("%s monitor cpu=%.2f%% mem=%.2f%% load=%.2f disk=%.2f%% net=%.2fBPS dbspeed=%.2fBPS dbsize=%d\n",
 		getReportTimeStr(r.GetTime()), r.GetCpu(), r.GetMemory(), r.GetLoad(), r.GetDisk(), r.GetNet(), r.GetDbSpeed(), r.GetDbSize())
+	fmt.Printf("lsm=%d vlog=%d\n", r.GetLsmSize(), r.GetVlogSize())
+	if gc := r.GetLastGc(); gc != nil {
+		printDBMaintenance(gc)
+	}
+	for _, u := range r.GetUsage() {
+		printDBUsage(u)
+	}
 }
@@ -0,0 +1,125 @@
+/*
+Copyright © 2025 Masayuki Yamai <twsnmp@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/twsnmp/twlogeye/api"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+var verifyArchiveFile string
+var verifyPubKey string
+
+// verifyCmd represents the verify command
+var verifyCmd = &cobra.Command{
+	Use:   "verify [<logtype>]",
+	Short: "Verify logs",
+	Long: `Verify hash chain and checkpoints of logs via api.
+logtype is syslog,trap,netflow,windows,otel,mqtt or all (default all).
+With --archive, verify archived file with its chain proof (<file>.chain.json) locally.
+Exit status is 1 if any gap or mismatch is found.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if verifyArchiveFile != "" {
+			verifyArchive()
+			return
+		}
+		t := "all"
+		if len(args) > 0 {
+			t = args[0]
+		}
+		verifyLog(t)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+	verifyCmd.Flags().StringVar(&startTime, "start", "", "start date and time")
+	verifyCmd.Flags().StringVar(&endTime, "end", "", "end date and time")
+	verifyCmd.Flags().StringVar(&verifyArchiveFile, "archive", "", "archived file to verify")
+	verifyCmd.Flags().StringVar(&verifyPubKey, "pubKey", "", "Ed25519 public key to verify checkpoints (default: public key of integrityKey in config)")
+}
+
+func verifyLog(t string) {
+	st := getTime(startTime, 0)
+	et := getTime(endTime, time.Now().UnixNano())
+	client := getClient()
+	s, err := client.VerifyLog(context.Background(), &api.LogRequest{
+		Logtype: t,
+		Start:   st,
+		End:     et,
+	})
+	if err != nil {
+		log.Fatalf("verify log err=%v", err)
+	}
+	ng := false
+	for {
+		r, err := s.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("verify log err=%v", err)
+		}
+		if r.GetBatches() == 0 && len(r.GetIssues()) == 0 {
+			fmt.Printf("%s no chain\n", r.GetType())
+			continue
+		}
+		fmt.Printf("%s batches=%d logs=%d expired=%d checkpoints=%d seq=%d-%d issues=%d\n", r.GetType(), r.GetBatches(), r.GetLogs(),
+			r.GetExpired(), r.GetCheckpoints(), r.GetFirstSeq(), r.GetLastSeq(), len(r.GetIssues()))
+		for _, i := range r.GetIssues() {
+			ng = true
+			fmt.Printf("  %s %s seq=%d %s\n", getReportTimeStr(i.GetTime()), i.GetKind(), i.GetSeq(), i.GetMessage())
+		}
+	}
+	if ng {
+		os.Exit(1)
+	}
+}
+
+func verifyArchive() {
+	var pub ed25519.PublicKey
+	if verifyPubKey != "" {
+		b, err := os.ReadFile(verifyPubKey)
+		if err != nil {
+			log.Fatalf("verify archive err=%v", err)
+		}
+		if pub, err = datastore.LoadIntegrityPublicKey(b); err != nil {
+			log.Fatalf("verify archive err=%v", err)
+		}
+	}
+	r, err := datastore.VerifyArchive(verifyArchiveFile, pub)
+	if err != nil {
+		log.Fatalf("verify archive err=%v", err)
+	}
+	fmt.Printf("%s batches=%d logs=%d expired=%d checkpoints=%d seq=%d-%d issues=%d\n", r.Type, r.Batches, r.Logs,
+		r.Expired, r.Checkpoints, r.FirstSeq, r.LastSeq, len(r.Issues))
+	for _, i := range r.Issues {
+		fmt.Printf("  %s %s seq=%d %s\n", getReportTimeStr(i.Time), i.Kind, i.Seq, i.Message)
+	}
+	if len(r.Issues) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,709 @@
+package datastore
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Archive directory has compressed NDJSON files of logs per log type per hour or day
+// <archiveDir>/<type>/<type>-<YYYYMMDD[HH]>.ndjson.gz|zst and manifest.json as time index.
+// <file>.chain.json has chain proof of logs in log integrity mode.
+// Logs saved late with time in archived period are marked with archivelate:<key of log>
+// and merged to archived file of the period.
+
+const archiveManifestFile = "manifest.json"
+
+// Max number of logs to save in one batch on import
+const archiveImportBatch = 10000
+
+// ArchiveEnt : archived file of logs. End is exclusive.
+type ArchiveEnt struct {
+	Type  string
+	Start int64
+	End   int64
+	File  string
+	Count int64
+	Size  int64
+	// Chain proof of logs in file (log integrity mode)
+	Proof string `json:",omitempty"`
+}
+
+// archiveLogEnt : log in archived file. Key is key of log in DB to verify chain proof.
+type archiveLogEnt struct {
+	LogEnt
+	Key string `json:",omitempty"`
+}
+
+type archiveManifestEnt struct {
+	Entries []*ArchiveEnt
+	// End of archived time per log type
+	Last map[string]int64
+}
+
+var archiveMu sync.Mutex
+var archiveManifest *archiveManifestEnt
+var archiveManifestDir string
+
+// archiveLastMap is end of archived (or archiving) time per log type to find late logs.
+var archiveLastMap sync.Map
+
+// StartArchiver : archive logs per hour or day before expiry
+func StartArchiver(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if Config.ArchiveDir == "" {
+		return
+	}
+	log.Printf("start archiver dir=%s", Config.ArchiveDir)
+	archiveMu.Lock()
+	_, err := loadArchiveManifest()
+	archiveMu.Unlock()
+	if err != nil {
+		log.Printf("load archive manifest err=%v", err)
+	}
+	p := getArchivePeriod()
+	for _, t := range getArchiveTypes() {
+		if r := getMinLogRetention(t); r < p+time.Hour {
+			log.Printf("log retention of %s is too short to archive retention=%v period=%v", t, r, p)
+		}
+	}
+	timer := time.NewTicker(time.Minute)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("stop archiver")
+			return
+		case <-timer.C:
+			if err := ArchiveLogs(time.Now()); err != nil {
+				log.Printf("archive logs err=%v", err)
+			}
+		}
+	}
+}
+
+func getArchivePeriod() time.Duration {
+	if Config.ArchivePeriod == "hour" {
+		return time.Hour
+	}
+	return time.Hour * 24
+}
+
+func getArchiveTypes() []string {
+	ret := []string{}
+	for _, t := range Config.ArchiveTypes {
+		if t = strings.TrimSpace(t); slices.Contains(logTypes, t) && !slices.Contains(ret, t) {
+			ret = append(ret, t)
+		}
+	}
+	if len(ret) < 1 {
+		return logTypes
+	}
+	return ret
+}
+
+// getMinLogRetention returns min TTL of log type.
+func getMinLogRetention(t string) time.Duration {
+	ret := time.Hour * time.Duration(Config.LogRetention)
+	for _, r := range getLogRetentionRules() {
+		if r.Type == t {
+			ret = min(ret, r.TTL)
+		}
+	}
+	return ret
+}
+
+// CheckArchiveConfig : check archive config
+func CheckArchiveConfig() error {
+	if Config.ArchiveDir == "" {
+		return nil
+	}
+	switch Config.ArchivePeriod {
+	case "", "hour", "day":
+	default:
+		return fmt.Errorf("invalid archive period %s", Config.ArchivePeriod)
+	}
+	switch Config.ArchiveCompress {
+	case "", "gzip", "zstd":
+	default:
+		return fmt.Errorf("invalid archive compress %s", Config.ArchiveCompress)
+	}
+	for _, t := range Config.ArchiveTypes {
+		if !slices.Contains(logTypes, strings.TrimSpace(t)) {
+			return fmt.Errorf("invalid archive log type %s", t)
+		}
+	}
+	return os.MkdirAll(Config.ArchiveDir, 0750)
+}
+
+// ArchiveLogs : archive logs of periods which ended before now
+func ArchiveLogs(now time.Time) error {
+	archiveMu.Lock()
+	defer archiveMu.Unlock()
+	m, err := loadArchiveManifest()
+	if err != nil {
+		return err
+	}
+	p := int64(getArchivePeriod())
+	// Wait a minute for logs of last period
+	end := now.Add(-time.Minute).UnixNano()
+	for _, t := range getArchiveTypes() {
+		if err := archiveLateLogs(m, t, p); err != nil {
+			return err
+		}
+		st := m.Last[t]
+		if st == 0 {
+			if st = getFirstLogTime(t, 0, end); st == 0 {
+				continue
+			}
+			st -= st % p
+		}
+		for ; st+p <= end; st += p {
+			// Logs saved while archiving are marked as late
+			archiveLastMap.Store(t, st+p)
+			e, err := archivePeriod(t, st, st+p)
+			if err != nil {
+				return err
+			}
+			if e != nil {
+				m.Entries = append(m.Entries, e)
+				log.Printf("archive %s logs=%d file=%s", t, e.Count, e.File)
+			}
+			m.Last[t] = st + p
+			if err := saveArchiveManifest(m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// archivePeriod writes logs in [st, et) to file. It returns nil if no logs.
+func archivePeriod(t string, st, et int64) (*ArchiveEnt, error) {
+	return writeArchivePeriod(t, st, et, func(write func(l *archiveLogEnt) bool) error {
+		forEachLog(t, st, et-1, func(k []byte, l *LogEnt) bool {
+			return write(&archiveLogEnt{LogEnt: *l, Key: string(k)})
+		})
+		return nil
+	})
+}
+
+// writeArchivePeriod writes logs from read in time order to file of period.
+func writeArchivePeriod(t string, st, et int64, read func(write func(l *archiveLogEnt) bool) error) (*ArchiveEnt, error) {
+	layout := "20060102"
+	if et-st < int64(time.Hour*24) {
+		layout = "2006010215"
+	}
+	ext := ".ndjson.gz"
+	if Config.ArchiveCompress == "zstd" {
+		ext = ".ndjson.zst"
+	}
+	name := filepath.Join(t, fmt.Sprintf("%s-%s%s", t, time.Unix(0, st).UTC().Format(layout), ext))
+	path := filepath.Join(Config.ArchiveDir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path + ".tmp")
+	var w io.WriteCloser
+	if Config.ArchiveCompress == "zstd" {
+		if w, err = zstd.NewWriter(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+	} else {
+		w = gzip.NewWriter(f)
+	}
+	e := &ArchiveEnt{Type: t, Start: st, End: et, File: filepath.ToSlash(name)}
+	enc := json.NewEncoder(w)
+	seqs := make(map[int64]bool)
+	err = read(func(l *archiveLogEnt) bool {
+		if err = enc.Encode(l); err != nil {
+			return false
+		}
+		if seq := getLogKeySeq([]byte(l.Key)); seq > 0 {
+			seqs[seq] = true
+		}
+		e.Count++
+		return true
+	})
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil || e.Count < 1 {
+		return nil, err
+	}
+	if s, err := os.Stat(path + ".tmp"); err == nil {
+		e.Size = s.Size()
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return nil, err
+	}
+	if Config.LogIntegrity {
+		proof, err := getLogChainProof(t, st, et, seqs)
+		if err != nil {
+			return nil, err
+		}
+		j, err := json.MarshalIndent(proof, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path+".chain.json", j, 0640); err != nil {
+			return nil, err
+		}
+		e.Proof = e.File + ".chain.json"
+	}
+	return e, nil
+}
+
+// getArchiveLast returns end of archived time of log type. 0 is not archived.
+func getArchiveLast(t string) int64 {
+	if Config.ArchiveDir == "" {
+		return 0
+	}
+	if v, ok := archiveLastMap.Load(t); ok {
+		return v.(int64)
+	}
+	return 0
+}
+
+// getArchivedEnd returns end of archived time of log type in manifest.
+func getArchivedEnd(t string) int64 {
+	archiveMu.Lock()
+	defer archiveMu.Unlock()
+	m, err := loadArchiveManifest()
+	if err != nil {
+		return 0
+	}
+	return m.Last[t]
+}
+
+// markArchiveLateLogs marks keys of logs with time in archived period to merge them to archive.
+func markArchiveLateLogs(t string, keys []string, ttls []time.Duration) {
+	if len(keys) < 1 {
+		return
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for i, k := range keys {
+		if err := wb.SetEntry(badger.NewEntry([]byte("archivelate:"+k), []byte{}).WithTTL(ttls[i])); err != nil {
+			log.Printf("mark late log err=%v", err)
+			return
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		log.Printf("mark late log err=%v", err)
+	}
+}
+
+// archiveLateLogs merges late logs to archived files.
+func archiveLateLogs(m *archiveManifestEnt, t string, p int64) error {
+	// Late logs per start of period
+	lateMap := make(map[int64][]*archiveLogEnt)
+	marks := [][]byte{}
+	last := m.Last[t]
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		prefix := []byte("archivelate:" + t + ":")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			mk := it.Item().KeyCopy(nil)
+			k := mk[len("archivelate:"):]
+			ts := getLogKeyTime(k)
+			if ts >= last {
+				// Not archived yet
+				continue
+			}
+			marks = append(marks, mk)
+			item, err := txn.Get(k)
+			if err != nil {
+				// Expired or deleted
+				continue
+			}
+			l := &archiveLogEnt{LogEnt: LogEnt{Time: ts}, Key: string(k)}
+			item.Value(func(v []byte) error {
+				l.Src, l.Log, _ = strings.Cut(string(v), "\t")
+				return nil
+			})
+			st := ts - ts%p
+			for _, e := range m.Entries {
+				if e.Type == t && e.Start <= ts && ts < e.End {
+					st = e.Start
+					break
+				}
+			}
+			lateMap[st] = append(lateMap[st], l)
+		}
+		return nil
+	})
+	if len(marks) < 1 {
+		return nil
+	}
+	starts := []int64{}
+	for st := range lateMap {
+		starts = append(starts, st)
+	}
+	slices.Sort(starts)
+	for _, st := range starts {
+		late := lateMap[st]
+		idx := slices.IndexFunc(m.Entries, func(e *ArchiveEnt) bool {
+			return e.Type == t && e.Start == st
+		})
+		var e *ArchiveEnt
+		var err error
+		if idx < 0 {
+			// No logs in period when archived
+			e, err = archivePeriod(t, st, st+p)
+		} else {
+			e, err = rearchivePeriod(m.Entries[idx], late)
+		}
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			continue
+		}
+		if idx < 0 {
+			m.Entries = append(m.Entries, e)
+		} else {
+			if old := m.Entries[idx].File; old != e.File {
+				os.Remove(filepath.Join(Config.ArchiveDir, filepath.FromSlash(old)))
+			}
+			m.Entries[idx] = e
+		}
+		log.Printf("archive late %s logs=%d late=%d file=%s", t, e.Count, len(late), e.File)
+		if err := saveArchiveManifest(m); err != nil {
+			return err
+		}
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, k := range marks {
+		if err := wb.Delete(k); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// rearchivePeriod merges late logs to archived file of period.
+func rearchivePeriod(e *ArchiveEnt, late []*archiveLogEnt) (*ArchiveEnt, error) {
+	sort.Slice(late, func(i, j int) bool {
+		return archiveLogLess(late[i], late[j])
+	})
+	path := filepath.Join(Config.ArchiveDir, filepath.FromSlash(e.File))
+	return writeArchivePeriod(e.Type, e.Start, e.End, func(write func(l *archiveLogEnt) bool) error {
+		i := 0
+		ok := true
+		err := scanArchiveFile(path, func(l *archiveLogEnt) bool {
+			for ; i < len(late) && archiveLogLess(late[i], l); i++ {
+				if ok = write(late[i]); !ok {
+					return false
+				}
+			}
+			if i < len(late) && late[i].Key == l.Key && l.Key != "" {
+				// Log saved while archiving is already in file
+				i++
+			}
+			ok = write(l)
+			return ok
+		})
+		if err != nil {
+			return err
+		}
+		for ; ok && i < len(late); i++ {
+			ok = write(late[i])
+		}
+		return nil
+	})
+}
+
+// archiveLogLess compares logs in order of key in DB.
+func archiveLogLess(a, b *archiveLogEnt) bool {
+	if a.Time != b.Time || a.Key == "" || b.Key == "" {
+		return a.Time < b.Time
+	}
+	return a.Key < b.Key
+}
+
+func loadArchiveManifest() (*archiveManifestEnt, error) {
+	if archiveManifest != nil && archiveManifestDir == Config.ArchiveDir {
+		return archiveManifest, nil
+	}
+	m := &archiveManifestEnt{Last: make(map[string]int64)}
+	j, err := os.ReadFile(filepath.Join(Config.ArchiveDir, archiveManifestFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(j, m); err != nil {
+			return nil, err
+		}
+		if m.Last == nil {
+			m.Last = make(map[string]int64)
+		}
+	}
+	archiveManifest = m
+	archiveManifestDir = Config.ArchiveDir
+	archiveLastMap.Clear()
+	for t, v := range m.Last {
+		archiveLastMap.Store(t, v)
+	}
+	return m, nil
+}
+
+func saveArchiveManifest(m *archiveManifestEnt) error {
+	j, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(Config.ArchiveDir, archiveManifestFile)
+	if err := os.WriteFile(path+".tmp", j, 0640); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// GetArchiveList : get archived files of log type (all if empty) in time range
+func GetArchiveList(t string, st, et int64) ([]*ArchiveEnt, error) {
+	if Config.ArchiveDir == "" {
+		return nil, fmt.Errorf("archive is disabled")
+	}
+	archiveMu.Lock()
+	defer archiveMu.Unlock()
+	m, err := loadArchiveManifest()
+	if err != nil {
+		return nil, err
+	}
+	if et == 0 {
+		et = time.Now().UnixNano()
+	}
+	ret := []*ArchiveEnt{}
+	for _, e := range m.Entries {
+		if (t == "" || e.Type == t) && e.End > st && e.Start <= et {
+			ret = append(ret, e)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Start < ret[j].Start
+	})
+	return ret, nil
+}
+
+// getFirstLogTime returns time of first log in DB in [st, et]. 0 is not found.
+func getFirstLogTime(t string, st, et int64) int64 {
+	ret := int64(0)
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		prefix := []byte(t + ":")
+		it.Seek([]byte(fmt.Sprintf("%s:%016x", t, st)))
+		if it.ValidForPrefix(prefix) {
+			if ts := getLogKeyTime(it.Item().Key()); ts <= et {
+				ret = ts
+			}
+		}
+		return nil
+	})
+	return ret
+}
+
+// searchLogWithArchive searches logs in time order from archive and DB.
+// Logs of archived periods are merged from archive and DB because logs of short retention
+// may be expired in DB while other logs of the period are still there.
+func searchLogWithArchive(t string, st, et int64, q *LogQuery, cb func(log *LogEnt) bool) {
+	list, err := GetArchiveList(t, st, et)
+	if err != nil {
+		log.Printf("search archive err=%v", err)
+	}
+	cur := st
+	for _, e := range list {
+		if cur < e.Start && !searchHotLog(t, cur, e.Start-1, q, cb) {
+			return
+		}
+		ps := max(cur, e.Start)
+		pe := min(et, e.End-1)
+		if ps <= pe && !mergeArchiveLog(t, e, ps, pe, q, func(l *LogEnt, _ bool) bool {
+			return cb(l)
+		}) {
+			return
+		}
+		cur = max(cur, pe+1)
+	}
+	if cur <= et {
+		searchHotLog(t, cur, et, q, cb)
+	}
+}
+
+// mergeArchiveLog calls cb with logs in [st, et] of archive and DB in time order.
+// Log in both is called once with hot=true. It returns false if callback stops.
+func mergeArchiveLog(t string, e *ArchiveEnt, st, et int64, q *LogQuery, cb func(l *LogEnt, hot bool) bool) bool {
+	next, stop := iter.Pull(func(yield func(*archiveLogEnt) bool) {
+		searchHotLogKey(t, st, et, q, func(k []byte, l *LogEnt) bool {
+			return yield(&archiveLogEnt{LogEnt: *l, Key: string(k)})
+		})
+	})
+	defer stop()
+	hot, hotOk := next()
+	// Logs in DB with same time as current log of archive
+	same := []*archiveLogEnt{}
+	sameTime := int64(-1)
+	flushSame := func() bool {
+		for _, h := range same {
+			if !cb(&h.LogEnt, true) {
+				return false
+			}
+		}
+		same = same[:0]
+		return true
+	}
+	ok := true
+	err := scanArchiveFile(filepath.Join(Config.ArchiveDir, filepath.FromSlash(e.File)), func(l *archiveLogEnt) bool {
+		if l.Time < st {
+			return true
+		}
+		if l.Time > et {
+			return false
+		}
+		if l.Time != sameTime {
+			if ok = flushSame(); !ok {
+				return false
+			}
+			for ; hotOk && hot.Time < l.Time; hot, hotOk = next() {
+				if ok = cb(&hot.LogEnt, true); !ok {
+					return false
+				}
+			}
+			for ; hotOk && hot.Time == l.Time; hot, hotOk = next() {
+				same = append(same, hot)
+			}
+			sameTime = l.Time
+		}
+		// Imported logs have other key in DB
+		if i := slices.IndexFunc(same, func(h *archiveLogEnt) bool {
+			return (h.Key == l.Key && l.Key != "") || (h.Src == l.Src && h.Log == l.Log)
+		}); i >= 0 {
+			h := same[i]
+			same = slices.Delete(same, i, i+1)
+			ok = cb(&h.LogEnt, true)
+			return ok
+		}
+		ok = cb(&l.LogEnt, false)
+		return ok
+	})
+	if err != nil {
+		log.Printf("read archive file=%s err=%v", e.File, err)
+	}
+	if !ok || !flushSame() {
+		return false
+	}
+	for ; hotOk; hot, hotOk = next() {
+		if !cb(&hot.LogEnt, true) {
+			return false
+		}
+	}
+	return true
+}
+
+// scanArchiveFile reads logs from archived file until callback returns false.
+func scanArchiveFile(path string, cb func(l *archiveLogEnt) bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader
+	if strings.HasSuffix(path, ".zst") {
+		zr, err := zstd.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	} else {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		r = gr
+	}
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for s.Scan() {
+		var l archiveLogEnt
+		if err := json.Unmarshal(s.Bytes(), &l); err != nil {
+			return err
+		}
+		if !cb(&l) {
+			return nil
+		}
+	}
+	return s.Err()
+}
+
+// ImportArchive : load archived logs in time range to DB. Periods of archive are imported as a whole.
+// Logs already in DB are skipped.
+func ImportArchive(t string, st, et int64) (int64, error) {
+	if !slices.Contains(logTypes, t) {
+		return 0, fmt.Errorf("invalid log type %s", t)
+	}
+	list, err := GetArchiveList(t, st, et)
+	if err != nil {
+		return 0, err
+	}
+	count := int64(0)
+	for _, e := range list {
+		logs := []*LogEnt{}
+		var saveErr error
+		mergeArchiveLog(t, e, e.Start, e.End-1, nil, func(l *LogEnt, hot bool) bool {
+			if hot {
+				return true
+			}
+			logs = append(logs, l)
+			if len(logs) >= archiveImportBatch {
+				if saveErr = saveLogs(t, logs, false); saveErr != nil {
+					return false
+				}
+				count += int64(len(logs))
+				logs = []*LogEnt{}
+			}
+			return true
+		})
+		err := saveErr
+		if err == nil && len(logs) > 0 {
+			err = saveLogs(t, logs, false)
+			count += int64(len(logs))
+		}
+		if err != nil {
+			return count, err
+		}
+		log.Printf("import archive file=%s", e.File)
+	}
+	return count, nil
+}
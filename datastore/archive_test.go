@@ -0,0 +1,245 @@
+package datastore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestArchive(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.ArchiveDir = t.TempDir()
+	Config.ArchivePeriod = "hour"
+	Config.ArchiveCompress = "zstd"
+	Config.ArchiveTypes = []string{"syslog"}
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.ArchiveDir = ""
+		Config.ArchiveTypes = nil
+	}()
+	if err := CheckArchiveConfig(); err != nil {
+		t.Fatal(err)
+	}
+	base := time.Now().Add(-time.Hour * 5).UnixNano()
+	base -= base % int64(time.Hour)
+	for i, m := range []int{10, 20, 70, 80, 130, 140} {
+		SaveLogs("syslog", []*LogEnt{{
+			Time: base + int64(time.Minute)*int64(m),
+			Src:  "192.168.1.1",
+			Log:  fmt.Sprintf(`{"seq":%d}`, i),
+		}})
+	}
+	if err := ArchiveLogs(time.Now()); err != nil {
+		t.Fatalf("archive err=%v", err)
+	}
+	list, err := GetArchiveList("syslog", 0, 0)
+	if err != nil || len(list) != 3 {
+		t.Fatalf("invalid archive list %v err=%v", list, err)
+	}
+	for _, e := range list {
+		if e.Count != 2 || e.End-e.Start != int64(time.Hour) || filepath.Ext(e.File) != ".zst" {
+			t.Errorf("invalid archive %+v", e)
+		}
+		if _, err := os.Stat(filepath.Join(Config.ArchiveDir, e.File)); err != nil {
+			t.Errorf("archive file err=%v", err)
+		}
+	}
+	// Archive again does nothing
+	ArchiveLogs(time.Now())
+	if list, _ := GetArchiveList("", 0, 0); len(list) != 3 {
+		t.Errorf("archive must not be duplicated %d", len(list))
+	}
+
+	// Expire logs before base+135m
+	db.Update(func(txn *badger.Txn) error {
+		ForEachLog("syslog", 0, base+int64(time.Minute)*135, func(l *LogEnt) bool {
+			txn.Delete([]byte(fmt.Sprintf("syslog:%016x:%04x", l.Time, 0)))
+			return true
+		})
+		return nil
+	})
+	hot := 0
+	ForEachLog("syslog", 0, 0, func(l *LogEnt) bool {
+		hot++
+		return true
+	})
+	if hot != 1 {
+		t.Fatalf("invalid hot logs %d", hot)
+	}
+	search := func(q string) []int64 {
+		lq, err := ParseLogQuery(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret := []int64{}
+		SearchLog("syslog", 0, 0, lq, func(l *LogEnt) bool {
+			ret = append(ret, l.Time)
+			return true
+		})
+		return ret
+	}
+	r := search("")
+	if len(r) != 6 {
+		t.Fatalf("invalid search with archive %v", r)
+	}
+	for i := 1; i < len(r); i++ {
+		if r[i] <= r[i-1] {
+			t.Errorf("search result must be sorted %v", r)
+		}
+	}
+	if r := search("seq>=4"); len(r) != 2 {
+		t.Errorf("invalid query result %v", r)
+	}
+	n := 0
+	SearchLog("syslog", 0, 0, nil, func(l *LogEnt) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("search must stop %d", n)
+	}
+
+	// Import
+	if c, err := ImportArchive("syslog", base, base+int64(time.Hour)*3); err != nil || c != 5 {
+		t.Fatalf("import count=%d err=%v", c, err)
+	}
+	hot = 0
+	ForEachLog("syslog", 0, 0, func(l *LogEnt) bool {
+		hot++
+		return true
+	})
+	if hot != 6 {
+		t.Errorf("invalid hot logs after import %d", hot)
+	}
+	if r := search(""); len(r) != 6 {
+		t.Errorf("invalid search after import %v", r)
+	}
+	// Log of short retention expired after first log of period in DB
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(fmt.Sprintf("syslog:%016x:%04x", base+int64(time.Minute)*20, 1)))
+	})
+	if r := search(""); len(r) != 6 || r[1] != base+int64(time.Minute)*20 {
+		t.Errorf("expired log must be searched from archive %v", r)
+	}
+	if c, err := ImportArchive("syslog", base, base+int64(time.Hour)); err != nil || c != 1 {
+		t.Errorf("import expired log count=%d err=%v", c, err)
+	}
+	if _, err := ImportArchive("unknown", 0, 0); err == nil {
+		t.Error("unknown log type must be error")
+	}
+
+	// Late logs are merged to archive
+	ArchiveLogs(time.Now())
+	if list, _ := GetArchiveList("syslog", 0, 0); len(list) != 3 || list[0].Count != 2 {
+		t.Fatalf("imported logs must not be archived again %v", list)
+	}
+	SaveLogs("syslog", []*LogEnt{
+		{Time: base + int64(time.Minute)*15, Src: "192.168.1.2", Log: "late 1"},
+		{Time: base + int64(time.Minute)*200, Src: "192.168.1.2", Log: "late 2"},
+	})
+	if err := ArchiveLogs(time.Now()); err != nil {
+		t.Fatalf("archive late logs err=%v", err)
+	}
+	list, _ = GetArchiveList("syslog", 0, 0)
+	if len(list) != 4 || list[0].Count != 3 || list[3].Count != 1 || list[3].Start != base+int64(time.Hour)*3 {
+		t.Fatalf("invalid archive list with late logs %v", list)
+	}
+	times := []int64{}
+	scanArchiveFile(filepath.Join(Config.ArchiveDir, list[0].File), func(l *archiveLogEnt) bool {
+		times = append(times, l.Time)
+		return true
+	})
+	if len(times) != 3 || times[1] != base+int64(time.Minute)*15 {
+		t.Errorf("late log must be merged in time order %v", times)
+	}
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte("archivelate:")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			t.Errorf("late mark not deleted %s", it.Item().Key())
+		}
+		return nil
+	})
+
+	// gzip
+	Config.ArchiveCompress = "gzip"
+	e, err := archivePeriod("syslog", base, base+int64(time.Hour))
+	if err != nil || e == nil || filepath.Ext(e.File) != ".gz" {
+		t.Fatalf("gzip archive %+v err=%v", e, err)
+	}
+	c := 0
+	scanArchiveFile(filepath.Join(Config.ArchiveDir, e.File), func(l *archiveLogEnt) bool {
+		c++
+		return true
+	})
+	// With late log
+	if c != 3 {
+		t.Errorf("invalid gzip archive count %d", c)
+	}
+}
+
+func TestDBQuotaArchive(t *testing.T) {
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.ArchiveDir = t.TempDir()
+	Config.ArchivePeriod = "hour"
+	Config.ArchiveTypes = []string{"syslog"}
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.ArchiveDir = ""
+		Config.ArchiveTypes = nil
+		Config.DBQuota = 0
+	}()
+	archiveMu.Lock()
+	archiveManifest = nil
+	archiveMu.Unlock()
+	now := time.Now().UnixNano()
+	logs := []*LogEnt{}
+	for i := range 20 {
+		// Logs of last hours and current period
+		ts := now - int64(time.Hour)*3 + int64(i)*int64(time.Minute)*10
+		if i >= 10 {
+			ts = now - int64(i)*int64(time.Millisecond)
+		}
+		logs = append(logs, &LogEnt{Time: ts, Src: "192.168.1.1", Log: strings.Repeat(fmt.Sprintf("log %d ", i), 20000)})
+	}
+	slices.SortFunc(logs, func(a, b *LogEnt) int {
+		return int(a.Time - b.Time)
+	})
+	SaveLogs("syslog", logs)
+	// Not archived logs are not evicted
+	if n, _ := evictOldestLogs("syslog", 1<<40); n != 0 {
+		t.Fatalf("logs not archived must not be evicted %d", n)
+	}
+	Config.DBQuota = 1
+	if n := CheckDBQuota(); n < 1 || n > 10 {
+		t.Fatalf("archived logs must be evicted n=%d", n)
+	}
+	c := 0
+	ForEachLog("syslog", 0, 0, func(l *LogEnt) bool {
+		c++
+		return true
+	})
+	if c < 10 {
+		t.Errorf("logs of current period must be kept %d", c)
+	}
+	archived := int64(0)
+	list, _ := GetArchiveList("syslog", 0, 0)
+	for _, e := range list {
+		archived += e.Count
+	}
+	if archived+int64(c) < 20 {
+		t.Errorf("evicted logs must be archived archived=%d hot=%d", archived, c)
+	}
+}
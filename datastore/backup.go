@@ -0,0 +1,239 @@
+package datastore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/badger/v4/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Backup file is a JSON manifest line followed by badger backup data.
+const backupFormat = "twlogeye-backup"
+const backupVersion = 1
+
+// BackupManifestEnt : manifest of backup
+type BackupManifestEnt struct {
+	Format  string
+	Version int
+	Time    int64
+	// DB version range of backup (Since=0 is full backup).
+	// Entries newer than Since are saved. Use Until as Since of next incremental backup.
+	Since uint64
+	Until uint64
+	// Time range of logs in DB
+	Start int64
+	End   int64
+}
+
+// RestoreResultEnt : result of restore
+type RestoreResultEnt struct {
+	Manifest *BackupManifestEnt
+	Keys     int64
+	Deleted  int64
+	Skipped  int64
+}
+
+// GetBackupPath : path of backup file on server (only file in backupDir)
+func GetBackupPath(name string) (string, error) {
+	if Config.BackupDir == "" {
+		return "", fmt.Errorf("backup on server is disabled (no backupDir)")
+	}
+	name = filepath.Clean(name)
+	if name == "." || !filepath.IsLocal(name) {
+		return "", fmt.Errorf("invalid backup file name %s", name)
+	}
+	return filepath.Join(Config.BackupDir, name), nil
+}
+
+// BackupDB : write full (since=0) or incremental backup to w
+func BackupDB(w io.Writer, since uint64) (*BackupManifestEnt, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db not open")
+	}
+	m := &BackupManifestEnt{
+		Format:  backupFormat,
+		Version: backupVersion,
+		Time:    time.Now().UnixNano(),
+		Since:   since,
+		Until:   db.MaxVersion(),
+	}
+	m.Start, m.End = getLogTimeRange()
+	j, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append(j, '\n')); err != nil {
+		return nil, err
+	}
+	until, err := db.Backup(w, since)
+	if err != nil {
+		return nil, err
+	}
+	m.Until = max(m.Until, until)
+	return m, nil
+}
+
+// getLogTimeRange returns time of oldest and newest log.
+func getLogTimeRange() (int64, int64) {
+	st := int64(0)
+	et := int64(0)
+	db.View(func(txn *badger.Txn) error {
+		for _, t := range logTypes {
+			prefix := []byte(t + ":")
+			opt := badger.DefaultIteratorOptions
+			opt.PrefetchValues = false
+			opt.Prefix = prefix
+			it := txn.NewIterator(opt)
+			it.Seek(prefix)
+			if it.ValidForPrefix(prefix) {
+				if ts := getLogKeyTime(it.Item().Key()); ts > 0 && (st == 0 || ts < st) {
+					st = ts
+				}
+			}
+			it.Close()
+			opt.Reverse = true
+			it = txn.NewIterator(opt)
+			it.Seek([]byte(t + ":z"))
+			if it.ValidForPrefix(prefix) {
+				et = max(et, getLogKeyTime(it.Item().Key()))
+			}
+			it.Close()
+		}
+		return nil
+	})
+	return st, et
+}
+
+func getLogKeyTime(k []byte) int64 {
+	a := strings.SplitN(string(k), ":", 3)
+	if len(a) != 3 {
+		return 0
+	}
+	var ts int64
+	if _, err := fmt.Sscanf(a[1], "%x", &ts); err != nil {
+		return 0
+	}
+	return ts
+}
+
+// RestoreDB : load backup from r. Only keys of prefixes (like notify or sigma) are restored if prefixes is not empty.
+// Full backup must be restored to empty DB (or DB without keys of prefixes).
+// Incremental backups must be restored after full backup in order.
+func RestoreDB(r io.Reader, prefixes []string) (*RestoreResultEnt, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db not open")
+	}
+	br := bufio.NewReaderSize(r, 64*1024)
+	l, err := br.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup manifest err=%v", err)
+	}
+	var m BackupManifestEnt
+	if err := json.Unmarshal(l, &m); err != nil || m.Format != backupFormat {
+		return nil, fmt.Errorf("invalid backup manifest")
+	}
+	if m.Version > backupVersion {
+		return nil, fmt.Errorf("unsupported backup version %d", m.Version)
+	}
+	if m.Since == 0 && !isEmptyDB(prefixes) {
+		return nil, fmt.Errorf("db is not empty")
+	}
+	ret := &RestoreResultEnt{Manifest: &m}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	now := uint64(time.Now().Unix())
+	buf := []byte{}
+	for {
+		var sz uint64
+		if err := binary.Read(br, binary.LittleEndian, &sz); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if uint64(cap(buf)) < sz {
+			buf = make([]byte, sz)
+		}
+		if _, err := io.ReadFull(br, buf[:sz]); err != nil {
+			return nil, err
+		}
+		list := &pb.KVList{}
+		if err := proto.Unmarshal(buf[:sz], list); err != nil {
+			return nil, err
+		}
+		var lastKey []byte
+		for _, kv := range list.Kv {
+			// Versions of same key are sorted from newest
+			if lastKey != nil && bytes.Equal(lastKey, kv.Key) {
+				continue
+			}
+			lastKey = kv.Key
+			if !matchBackupPrefix(kv.Key, prefixes) {
+				ret.Skipped++
+				continue
+			}
+			if (len(kv.Meta) > 0 && kv.Meta[0]&0x01 != 0) || (kv.ExpiresAt > 0 && kv.ExpiresAt <= now) {
+				// Deleted or expired
+				if m.Since > 0 {
+					if err := wb.Delete(kv.Key); err != nil {
+						return nil, err
+					}
+					ret.Deleted++
+				}
+				continue
+			}
+			e := badger.NewEntry(kv.Key, kv.Value)
+			e.ExpiresAt = kv.ExpiresAt
+			if len(kv.UserMeta) > 0 {
+				e.UserMeta = kv.UserMeta[0]
+			}
+			if err := wb.SetEntry(e); err != nil {
+				return nil, err
+			}
+			ret.Keys++
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return nil, err
+	}
+	resetLogIndexInfo()
+	resetLogChain()
+	return ret, nil
+}
+
+func matchBackupPrefix(k []byte, prefixes []string) bool {
+	if len(prefixes) < 1 {
+		return true
+	}
+	p, _, _ := strings.Cut(string(k), ":")
+	return slices.Contains(prefixes, p)
+}
+
+func isEmptyDB(prefixes []string) bool {
+	empty := true
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			if matchBackupPrefix(it.Item().Key(), prefixes) {
+				empty = false
+				break
+			}
+		}
+		return nil
+	})
+	return empty
+}
@@ -0,0 +1,121 @@
+package datastore
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBackupRestore(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.NotifyRetention = 7
+	OpenDB()
+	defer CloseDB()
+	now := time.Now().UnixNano()
+	mkLogs := func(st, n int) []*LogEnt {
+		logs := []*LogEnt{}
+		for i := st; i < st+n; i++ {
+			logs = append(logs, &LogEnt{
+				Time: now + int64(i)*int64(time.Second),
+				Src:  "192.168.1.1",
+				Log:  fmt.Sprintf(`{"seq":%d}`, i),
+			})
+		}
+		return logs
+	}
+	SaveLogs("syslog", mkLogs(0, 10))
+	SaveNotify(&NotifyEnt{Time: now, ID: "rule1", Src: "192.168.1.1", Level: "high", Title: "test"})
+	full := new(bytes.Buffer)
+	m, err := BackupDB(full, 0)
+	if err != nil {
+		t.Fatalf("backup err=%v", err)
+	}
+	if m.Since != 0 || m.Until < 1 || m.Start != now || m.End != now+9*int64(time.Second) {
+		t.Fatalf("invalid manifest %+v", m)
+	}
+	SaveLogs("syslog", mkLogs(10, 5))
+	inc := new(bytes.Buffer)
+	mi, err := BackupDB(inc, m.Until)
+	if err != nil {
+		t.Fatalf("incremental backup err=%v", err)
+	}
+	if mi.Since != m.Until || mi.Until <= m.Until {
+		t.Fatalf("invalid incremental manifest %+v", mi)
+	}
+	fullData := full.Bytes()
+	if _, err := RestoreDB(bytes.NewReader(fullData), nil); err == nil {
+		t.Error("restore to not empty db must be error")
+	}
+
+	countLogs := func() int {
+		n := 0
+		ForEachLog("syslog", 0, now+int64(time.Hour), func(l *LogEnt) bool {
+			n++
+			return true
+		})
+		return n
+	}
+	countNotify := func() int {
+		n := 0
+		ForEachNotify(0, now+int64(time.Hour), func(e *NotifyEnt) bool {
+			n++
+			return true
+		})
+		return n
+	}
+	// Restore full and incremental
+	CloseDB()
+	OpenDB()
+	r, err := RestoreDB(bytes.NewReader(fullData), nil)
+	if err != nil || r.Keys != 11 {
+		t.Fatalf("restore full r=%+v err=%v", r, err)
+	}
+	if r, err := RestoreDB(bytes.NewReader(inc.Bytes()), nil); err != nil || r.Keys != 5 {
+		t.Fatalf("restore incremental r=%+v err=%v", r, err)
+	}
+	if n := countLogs(); n != 15 {
+		t.Errorf("invalid log count %d", n)
+	}
+	if n := countNotify(); n != 1 {
+		t.Errorf("invalid notify count %d", n)
+	}
+	// Restore only notify
+	CloseDB()
+	OpenDB()
+	r, err = RestoreDB(bytes.NewReader(fullData), []string{"notify"})
+	if err != nil || r.Keys != 1 || r.Skipped != 10 {
+		t.Fatalf("restore notify r=%+v err=%v", r, err)
+	}
+	if n := countLogs(); n != 0 {
+		t.Errorf("logs must not be restored %d", n)
+	}
+	if n := countNotify(); n != 1 {
+		t.Errorf("invalid notify count %d", n)
+	}
+	if _, err := RestoreDB(bytes.NewReader([]byte("{}\n")), nil); err == nil {
+		t.Error("invalid manifest must be error")
+	}
+}
+
+func TestGetBackupPath(t *testing.T) {
+	defer func() {
+		Config.BackupDir = ""
+	}()
+	Config.BackupDir = ""
+	if _, err := GetBackupPath("full.bak"); err == nil {
+		t.Error("backup on server without backupDir must be error")
+	}
+	Config.BackupDir = "/var/backup"
+	if p, err := GetBackupPath("daily/full.bak"); err != nil || p != filepath.Join("/var/backup", "daily", "full.bak") {
+		t.Errorf("invalid backup path %s err=%v", p, err)
+	}
+	for _, name := range []string{"../twlogeye.yaml", "/etc/twlogeye.yaml", "a/../../db", "", "."} {
+		if p, err := GetBackupPath(name); err == nil {
+			t.Errorf("%q must be error p=%s", name, p)
+		}
+	}
+}
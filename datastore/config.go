@@ -39,12 +39,77 @@ type ConfigEnt struct {
 	TrapDst       []string `yaml:"trapDst"`
 	WebhookDst    []string `yaml:"webhookDst"`
 	MqttDst       []string `yaml:"mqttDst"`
+	MailDst       []string `yaml:"mailDst"`
 	TrapCommunity string   `yaml:"trapCommunity"`
+	// Incident grouping window (minute, 0=disable)
+	IncidentWindow int `yaml:"incidentWindow"`
+	// Interval of incident update notify (minute)
+	IncidentUpdateInterval int `yaml:"incidentUpdateInterval"`
+	// Key fields of incident (id,src,level,type,title,tags)
+	IncidentKey []string `yaml:"incidentKey"`
+	// Notify routes ("name=x level=high+ tag=attack.* dst=webhook:1|mail rate=10/1h dedup=10m schedule=mon-fri/09:00-18:00")
+	NotifyRoute []string `yaml:"notifyRoute"`
+	// Notify escalation policies ("name=x level=critical route=soc step=15m step=30m/mail")
+	NotifyEscalation []string `yaml:"notifyEscalation"`
+	// Response actions ("name=x type=exec|http|file ...") run on routes with action=<name>
+	NotifyAction []string `yaml:"notifyAction"`
+	// Record response actions without running them
+	NotifyActionDryRun bool `yaml:"notifyActionDryRun"`
 
 	// Log retention period (hours)
 	LogRetention int `yaml:"logRetention"`
+	// Log retention period per log type or source ("<type>[/<src>]=<hours>")
+	LogRetentionRule []string `yaml:"logRetentionRule"`
+	// DB size quota on disk (MB, 0=disable)
+	DBQuota int `yaml:"dbQuota"`
+	// Log types from low to high priority to keep under DB quota
+	LogPriority []string `yaml:"logPriority"`
+	// Interval of value log GC (minute, 0=disable)
+	DBGCInterval int `yaml:"dbGCInterval"`
+	// Discard ratio of value log GC (0.0-1.0)
+	DBGCDiscardRatio float64 `yaml:"dbGCDiscardRatio"`
+	// Interval of LSM tree compaction (hours, 0=disable)
+	DBCompactInterval int `yaml:"dbCompactInterval"`
+	// Time range to run DB maintenance like "01:00-05:00" (empty=any time)
+	DBGCQuietHours string `yaml:"dbGCQuietHours"`
+	// File of DB encryption key (AES 16/24/32 bytes or hex)
+	DBEncryptionKey string `yaml:"dbEncryptionKey"`
+	// Environment variable of DB encryption key
+	DBEncryptionKeyEnv string `yaml:"dbEncryptionKeyEnv"`
+	// Command to print DB encryption key
+	DBEncryptionKeyCmd string `yaml:"dbEncryptionKeyCmd"`
+	// Rotation period of data keys of encrypted DB (days, 0=badger default)
+	DBKeyRotation int `yaml:"dbKeyRotation"`
+	// Index cache size of DB (MB, 0=all indices in memory or 64MB for encrypted DB)
+	DBIndexCacheSize int `yaml:"dbIndexCacheSize"`
+	// Chain SHA-256 of saved logs per log type
+	LogIntegrity bool `yaml:"logIntegrity"`
+	// Ed25519 private key (PEM) to sign checkpoints of log chain
+	IntegrityKey string `yaml:"integrityKey"`
+	// Interval of checkpoints of log chain (minute)
+	IntegrityCheckpoint int `yaml:"integrityCheckpoint"`
+	// Directory to save backup files on server (empty=disable)
+	BackupDir string `yaml:"backupDir"`
+	// Directory to archive logs (empty=disable)
+	ArchiveDir string `yaml:"archiveDir"`
+	// Period of archive file (hour or day)
+	ArchivePeriod string `yaml:"archivePeriod"`
+	// Compression of archive file (gzip or zstd)
+	ArchiveCompress string `yaml:"archiveCompress"`
+	// Log types to archive (empty=all)
+	ArchiveTypes []string `yaml:"archiveTypes"`
+	// Log index (src, token and field names like hostname)
+	LogIndex []string `yaml:"logIndex"`
+	// Time bucket of log index (minute)
+	LogIndexBucket int `yaml:"logIndexBucket"`
 	// Notify retention period (days)
 	NotifyRetention int `yaml:"notifyRetention"`
+	// First retry interval of failed notify delivery (sec)
+	NotifyRetryInterval int `yaml:"notifyRetryInterval"`
+	// Max retry interval of failed notify delivery (sec)
+	NotifyRetryMaxInterval int `yaml:"notifyRetryMaxInterval"`
+	// Max age of notify delivery before dead letter (minute)
+	NotifyMaxAge int `yaml:"notifyMaxAge"`
 	// Report retention period (days)
 	ReportRetention int `yaml:"reportRetention"`
 	// Report interval (minute)
@@ -57,6 +122,32 @@ type ConfigEnt struct {
 	AnomalyUseTimeData bool `yaml:"anomalyUseTimeData"`
 	// Grace period for sending notifications when detecting anomalies
 	AnomalyNotifyDelay int `yaml:"anomalyNotifyDelay"`
+	// Sampling rate for exporters without sampling info (rate or IP=rate)
+	NetflowSamplingRate []string `yaml:"netflowSamplingRate"`
+	// Port scan detection window (sec)
+	NetflowScanWindow int `yaml:"netflowScanWindow"`
+	// Number of ports on one target to detect vertical scan (0=disable)
+	NetflowScanPorts int `yaml:"netflowScanPorts"`
+	// Number of targets on one port to detect horizontal sweep (0=disable)
+	NetflowScanHosts int `yaml:"netflowScanHosts"`
+	// Number of sources to one target to detect distributed scan (0=disable)
+	NetflowScanSrcs int `yaml:"netflowScanSrcs"`
+	// Number of ports on one target to detect distributed scan
+	NetflowScanSrcPorts int `yaml:"netflowScanSrcPorts"`
+	// Beacon detection window (hours)
+	NetflowBeaconWindow int `yaml:"netflowBeaconWindow"`
+	// Minimum number of connections to check beacon
+	NetflowBeaconMinCount int `yaml:"netflowBeaconMinCount"`
+	// Score threshold to notify beacon (0.0-1.0, 0=disable)
+	NetflowBeaconScore float64 `yaml:"netflowBeaconScore"`
+	// Allow list for beacon detection (IP, CIDR, :port or host name)
+	NetflowBeaconAllow []string `yaml:"netflowBeaconAllow"`
+	// Max number of conversations to track for beacon detection (0=unlimited)
+	NetflowBeaconMaxEntries int `yaml:"netflowBeaconMaxEntries"`
+	// Interface speed and utilisation threshold ("exporter ifIndex|ifName speedMbps percent")
+	NetflowIfThreshold []string `yaml:"netflowIfThreshold"`
+	// Number of consecutive intervals over threshold to notify
+	NetflowIfThresholdCount int `yaml:"netflowIfThresholdCount"`
 	// GROK
 	GrokPat []string `yaml:"grokPat"`
 	GrokDef string   `yaml:"grokDef"`
@@ -67,6 +158,8 @@ type ConfigEnt struct {
 
 	// Resolve Host Name
 	ResolveHostName bool `yaml:"resolveHostName"`
+	// Network zone map (CIDR=Zone name)
+	Zones []string `yaml:"zones"`
 	// GeoIP DB
 	GeoIPDB string `yaml:"geoIPDB"`
 
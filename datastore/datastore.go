@@ -10,16 +10,16 @@ var db *badger.DB
 
 // OpenDB : open log database
 func OpenDB() {
-	dbPath := Config.DBPath
-	opt := badger.DefaultOptions(dbPath)
-	if dbPath == "" {
-		opt = opt.WithInMemory(true)
+	opt, err := getDBOptions()
+	if err != nil {
+		log.Fatalln(err)
 	}
-	var err error
 	db, err = badger.Open(opt)
 	if err != nil {
-		log.Fatalln(err)
+		log.Fatalln(openDBError(err, len(opt.EncryptionKey) > 0))
 	}
+	resetLogIndexInfo()
+	resetLogChain()
 }
 
 // CloseLogDB : close log database
@@ -0,0 +1,187 @@
+package datastore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+const defaultDBIndexCacheSize = 64 << 20
+
+// DBKeySourceEnt : source of DB encryption key (file, environment variable or command)
+type DBKeySourceEnt struct {
+	File string
+	Env  string
+	Cmd  string
+}
+
+func (s DBKeySourceEnt) isEmpty() bool {
+	return s.File == "" && s.Env == "" && s.Cmd == ""
+}
+
+// getDBKeySource : DB encryption key source of config
+func getDBKeySource() DBKeySourceEnt {
+	return DBKeySourceEnt{
+		File: Config.DBEncryptionKey,
+		Env:  Config.DBEncryptionKeyEnv,
+		Cmd:  Config.DBEncryptionKeyCmd,
+	}
+}
+
+// CheckDBEncryptionConfig : check DB encryption key of config
+func CheckDBEncryptionConfig() error {
+	if Config.DBIndexCacheSize < 0 {
+		return fmt.Errorf("invalid dbIndexCacheSize %d", Config.DBIndexCacheSize)
+	}
+	if Config.DBKeyRotation < 0 {
+		return fmt.Errorf("invalid dbKeyRotation %d", Config.DBKeyRotation)
+	}
+	_, err := LoadDBEncryptionKey(getDBKeySource())
+	return err
+}
+
+// LoadDBEncryptionKey : load DB encryption key (nil=no encryption)
+func LoadDBEncryptionKey(s DBKeySourceEnt) ([]byte, error) {
+	n := 0
+	for _, v := range []string{s.File, s.Env, s.Cmd} {
+		if v != "" {
+			n++
+		}
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	if n > 1 {
+		return nil, fmt.Errorf("set only one of DB encryption key file, env or cmd")
+	}
+	var b []byte
+	var err error
+	switch {
+	case s.File != "":
+		b, err = os.ReadFile(s.File)
+		if err != nil {
+			return nil, fmt.Errorf("read DB encryption key err=%v", err)
+		}
+	case s.Env != "":
+		v, ok := os.LookupEnv(s.Env)
+		if !ok || v == "" {
+			return nil, fmt.Errorf("DB encryption key env %s is not set", s.Env)
+		}
+		b = []byte(v)
+	default:
+		args := strings.Fields(s.Cmd)
+		if len(args) < 1 {
+			return nil, fmt.Errorf("DB encryption key cmd is empty")
+		}
+		b, err = exec.Command(args[0], args[1:]...).Output()
+		if err != nil {
+			return nil, fmt.Errorf("DB encryption key cmd err=%v", err)
+		}
+	}
+	return parseDBEncryptionKey(b)
+}
+
+// parseDBEncryptionKey : AES-128/192/256 key as raw bytes or hex string
+func parseDBEncryptionKey(b []byte) ([]byte, error) {
+	k := bytes.TrimSpace(b)
+	switch len(k) {
+	case 32, 48, 64:
+		if h, err := hex.DecodeString(string(k)); err == nil {
+			return h, nil
+		}
+	}
+	switch len(k) {
+	case 16, 24, 32:
+		return k, nil
+	}
+	return nil, fmt.Errorf("DB encryption key must be 16, 24 or 32 bytes (or hex) len=%d", len(k))
+}
+
+// getDBOptions : badger options with DB encryption and index cache
+func getDBOptions() (badger.Options, error) {
+	opt := badger.DefaultOptions(Config.DBPath)
+	if Config.DBPath == "" {
+		opt = opt.WithInMemory(true)
+	}
+	key, err := LoadDBEncryptionKey(getDBKeySource())
+	if err != nil {
+		return opt, err
+	}
+	if len(key) > 0 {
+		opt = opt.WithEncryptionKey(key)
+		if Config.DBKeyRotation > 0 {
+			opt = opt.WithEncryptionKeyRotationDuration(time.Duration(Config.DBKeyRotation) * 24 * time.Hour)
+		}
+	}
+	if Config.DBIndexCacheSize > 0 {
+		opt = opt.WithIndexCacheSize(int64(Config.DBIndexCacheSize) << 20)
+	} else if len(key) > 0 {
+		// badger needs index cache for encrypted DB
+		opt = opt.WithIndexCacheSize(defaultDBIndexCacheSize)
+	}
+	return opt, nil
+}
+
+// openDBError : make error of wrong or missing DB encryption key clear
+func openDBError(err error, encrypted bool) error {
+	switch {
+	case errors.Is(err, badger.ErrEncryptionKeyMismatch) && encrypted:
+		return fmt.Errorf("open db err=%v: DB encryption key is wrong or DB is not encrypted", err)
+	case errors.Is(err, badger.ErrEncryptionKeyMismatch):
+		return fmt.Errorf("open db err=%v: DB is encrypted, DB encryption key is required", err)
+	}
+	return fmt.Errorf("open db err=%v", err)
+}
+
+// RekeyDB : rotate DB encryption key (twlogeye must be stopped)
+func RekeyDB(oldKey, newKey DBKeySourceEnt) error {
+	if Config.DBPath == "" {
+		return fmt.Errorf("rekey needs dbPath")
+	}
+	if oldKey.isEmpty() || newKey.isEmpty() {
+		return fmt.Errorf("rekey needs current and new DB encryption key")
+	}
+	if _, err := os.Stat(filepath.Join(Config.DBPath, badger.KeyRegistryFileName)); err != nil {
+		return fmt.Errorf("DB not found in %s", Config.DBPath)
+	}
+	cur, err := LoadDBEncryptionKey(oldKey)
+	if err != nil {
+		return err
+	}
+	nk, err := LoadDBEncryptionKey(newKey)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(cur, nk) {
+		return fmt.Errorf("new DB encryption key is same as current key")
+	}
+	// Check current key and lock of DB
+	opt := badger.DefaultOptions(Config.DBPath).WithEncryptionKey(cur).
+		WithIndexCacheSize(defaultDBIndexCacheSize).WithLogger(nil)
+	d, err := badger.Open(opt)
+	if err != nil {
+		return openDBError(err, true)
+	}
+	d.Close()
+	kopt := badger.KeyRegistryOptions{
+		Dir:                           Config.DBPath,
+		ReadOnly:                      true,
+		EncryptionKey:                 cur,
+		EncryptionKeyRotationDuration: opt.EncryptionKeyRotationDuration,
+	}
+	kr, err := badger.OpenKeyRegistry(kopt)
+	if err != nil {
+		return openDBError(err, true)
+	}
+	defer kr.Close()
+	kopt.EncryptionKey = nk
+	return badger.WriteKeyRegistry(kr, kopt)
+}
@@ -0,0 +1,127 @@
+package datastore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestParseDBEncryptionKey(t *testing.T) {
+	for _, c := range []struct {
+		key string
+		len int
+	}{
+		{"0123456789abcdef", 16},
+		{"0123456789abcdef01234567\n", 24},
+		{"0123456789abcdef0123456789abcdef", 16},
+		{strings.Repeat("a1", 32), 32},
+		{"short", 0},
+		{strings.Repeat("x", 64), 0},
+	} {
+		k, err := parseDBEncryptionKey([]byte(c.key))
+		if c.len == 0 {
+			if err == nil {
+				t.Errorf("%q must be error", c.key)
+			}
+			continue
+		}
+		if err != nil || len(k) != c.len {
+			t.Errorf("%q len=%d err=%v", c.key, len(k), err)
+		}
+	}
+	if _, err := LoadDBEncryptionKey(DBKeySourceEnt{File: "a", Env: "b"}); err == nil {
+		t.Error("multiple key sources must be error")
+	}
+	t.Setenv("TWLOGEYE_TEST_DBKEY", "0123456789abcdef")
+	if k, err := LoadDBEncryptionKey(DBKeySourceEnt{Env: "TWLOGEYE_TEST_DBKEY"}); err != nil || len(k) != 16 {
+		t.Errorf("env key len=%d err=%v", len(k), err)
+	}
+	if _, err := LoadDBEncryptionKey(DBKeySourceEnt{Cmd: "  "}); err == nil {
+		t.Error("empty key cmd must be error")
+	}
+	if _, err := LoadDBEncryptionKey(DBKeySourceEnt{Env: "TWLOGEYE_TEST_NO_DBKEY"}); err == nil {
+		t.Error("missing env key must be error")
+	}
+}
+
+func TestDBEncryption(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := filepath.Join(dir, "old.key")
+	newKey := filepath.Join(dir, "new.key")
+	os.WriteFile(oldKey, []byte(strings.Repeat("01", 32)), 0600)
+	os.WriteFile(newKey, []byte(strings.Repeat("02", 32)), 0600)
+	defer func() {
+		Config.DBPath = ""
+		Config.DBEncryptionKey = ""
+	}()
+	Config.DBPath = filepath.Join(dir, "db")
+	Config.DBEncryptionKey = oldKey
+	Config.LogRetention = 24
+	OpenDB()
+	now := time.Now().UnixNano()
+	SaveLogs("syslog", []*LogEnt{{Time: now, Src: "192.168.1.1", Log: "user=admin password=secret"}})
+	CloseDB()
+
+	open := func() error {
+		opt, err := getDBOptions()
+		if err != nil {
+			return err
+		}
+		d, err := badger.Open(opt.WithLogger(nil))
+		if err != nil {
+			return openDBError(err, len(opt.EncryptionKey) > 0)
+		}
+		d.Close()
+		return nil
+	}
+	Config.DBEncryptionKey = newKey
+	if err := open(); err == nil || !strings.Contains(err.Error(), "wrong") {
+		t.Fatalf("wrong key err=%v", err)
+	}
+	Config.DBEncryptionKey = ""
+	if err := open(); err == nil || !strings.Contains(err.Error(), "required") {
+		t.Fatalf("missing key err=%v", err)
+	}
+
+	if err := RekeyDB(DBKeySourceEnt{File: newKey}, DBKeySourceEnt{File: oldKey}); err == nil {
+		t.Fatal("rekey with wrong key must be error")
+	}
+	if err := RekeyDB(DBKeySourceEnt{File: oldKey}, DBKeySourceEnt{File: newKey}); err != nil {
+		t.Fatalf("rekey err=%v", err)
+	}
+	Config.DBEncryptionKey = oldKey
+	if err := open(); err == nil {
+		t.Fatal("old key must be error after rekey")
+	}
+	Config.DBEncryptionKey = newKey
+	OpenDB()
+	n := 0
+	SearchLog("syslog", 0, now+1, nil, func(l *LogEnt) bool {
+		if l.Log == "user=admin password=secret" {
+			n++
+		}
+		return true
+	})
+	CloseDB()
+	if n != 1 {
+		t.Fatalf("logs after rekey=%d", n)
+	}
+
+	// In-memory DB with encryption
+	Config.DBPath = ""
+	OpenDB()
+	SaveLogs("syslog", []*LogEnt{{Time: now, Src: "192.168.1.1", Log: "test"}})
+	n = 0
+	SearchLog("syslog", 0, now+1, nil, func(l *LogEnt) bool {
+		n++
+		return true
+	})
+	CloseDB()
+	if n != 1 {
+		t.Fatalf("in-memory logs=%d", n)
+	}
+}
@@ -0,0 +1,78 @@
+package datastore
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// EscalationEnt is pending escalation of unacknowledged notify.
+type EscalationEnt struct {
+	// Workflow target of notify
+	Target string
+	Policy string
+	Notify NotifyEnt
+	// Destinations of first notify
+	Dst []string
+	// Index of next step
+	Step    int
+	Created int64
+	Next    int64
+}
+
+func SaveEscalation(e *EscalationEnt) {
+	db.Update(func(txn *badger.Txn) error {
+		v, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		en := badger.NewEntry([]byte("escalation:"+e.Target), v).WithTTL(time.Hour * 24 * time.Duration(Config.NotifyRetention))
+		return txn.SetEntry(en)
+	})
+}
+
+func GetEscalation(target string) *EscalationEnt {
+	var ret *EscalationEnt
+	db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte("escalation:" + target))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(v []byte) error {
+			var e EscalationEnt
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			ret = &e
+			return nil
+		})
+	})
+	return ret
+}
+
+func DeleteEscalation(target string) {
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte("escalation:" + target))
+	})
+}
+
+func ForEachEscalation(callBack func(e *EscalationEnt) bool) {
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte("escalation:")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			var e EscalationEnt
+			if err := it.Item().Value(func(v []byte) error {
+				return json.Unmarshal(v, &e)
+			}); err != nil {
+				continue
+			}
+			if !callBack(&e) {
+				break
+			}
+		}
+		return nil
+	})
+}
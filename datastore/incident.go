@@ -0,0 +1,89 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// IncidentEnt is group of repeated notify.
+type IncidentEnt struct {
+	ID string
+	// Values of key fields
+	Key       string
+	Notify    NotifyEnt
+	Count     int
+	FirstSeen int64
+	LastSeen  int64
+	// Count when last notified
+	NotifiedCount int
+	LastNotified  int64
+	Closed        bool
+}
+
+func getIncidentKey(id string) string {
+	return "incident:" + id
+}
+
+// NewIncidentID makes incident ID from first seen time.
+func NewIncidentID(t int64) string {
+	return fmt.Sprintf("%016x", t)
+}
+
+func SaveIncident(i *IncidentEnt) {
+	db.Update(func(txn *badger.Txn) error {
+		v, err := json.Marshal(i)
+		if err != nil {
+			return err
+		}
+		e := badger.NewEntry([]byte(getIncidentKey(i.ID)), v).WithTTL(time.Hour * 24 * time.Duration(Config.NotifyRetention))
+		return txn.SetEntry(e)
+	})
+}
+
+func GetIncident(id string) *IncidentEnt {
+	var ret *IncidentEnt
+	db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(getIncidentKey(id)))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(v []byte) error {
+			var i IncidentEnt
+			if err := json.Unmarshal(v, &i); err != nil {
+				return err
+			}
+			ret = &i
+			return nil
+		})
+	})
+	return ret
+}
+
+// ForEachIncident calls callBack for incidents first seen between st and et.
+func ForEachIncident(st, et int64, callBack func(i *IncidentEnt) bool) {
+	if et == 0 {
+		et = time.Now().UnixNano()
+	}
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek([]byte(getIncidentKey(NewIncidentID(st)))); it.ValidForPrefix([]byte("incident:")); it.Next() {
+			var i IncidentEnt
+			if err := it.Item().Value(func(v []byte) error {
+				return json.Unmarshal(v, &i)
+			}); err != nil {
+				continue
+			}
+			if i.FirstSeen > et {
+				break
+			}
+			if !callBack(&i) {
+				break
+			}
+		}
+		return nil
+	})
+}
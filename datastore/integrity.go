@@ -0,0 +1,908 @@
+package datastore
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Log integrity chain. Each batch of SaveLogs is chained by SHA-256 per log type.
+// Logs of batch have seq of batch in key <type>:<time>:<index>:<seq>.
+// logchain:<type>:<seq> -> LogChainEnt
+// logchaintime:<type>:<start>:<seq> -> time index of LogChainEnt
+// logchainhead:<type> -> last LogChainEnt
+// logcheckpoint:<type>:<time> -> LogCheckpointEnt signed by Ed25519 key
+
+// LogChainEnt : hash of batch of logs.
+// Hash is SHA-256 of Prev and SHA-256 of key and value of each log in key order.
+type LogChainEnt struct {
+	Type  string
+	Seq   int64
+	Start int64
+	End   int64
+	Count int64
+	// Time when first log of batch expires
+	Expire int64
+	Prev   string
+	Hash   string
+}
+
+// LogCheckpointEnt : Ed25519 signature of chain record
+type LogCheckpointEnt struct {
+	Type  string
+	Seq   int64
+	Hash  string
+	Time  int64
+	KeyID string
+	Sig   string
+}
+
+// LogVerifyEnt : result of log verification per log type
+type LogVerifyEnt struct {
+	Type        string
+	Batches     int64
+	Logs        int64
+	Expired     int64
+	Checkpoints int64
+	FirstSeq    int64
+	LastSeq     int64
+	Issues      []*LogVerifyIssueEnt
+}
+
+// LogVerifyIssueEnt : gap, mismatch, chain, unchained or checkpoint issue
+type LogVerifyIssueEnt struct {
+	Kind    string
+	Seq     int64
+	Time    int64
+	Message string
+}
+
+// LogChainProofEnt : chain records and checkpoints to prove archived logs in [Start, End).
+// Chain has all records from first to last seq of logs in file.
+type LogChainProofEnt struct {
+	Type      string
+	Start     int64
+	End       int64
+	PublicKey string `json:",omitempty"`
+	Chain     []*LogChainEnt
+	// SHA-256 of logs of batches which are not in file (key -> hash)
+	Leaves map[string]string `json:",omitempty"`
+	// Seq of batches which have expired logs not in file
+	Expired     []int64 `json:",omitempty"`
+	Checkpoints []*LogCheckpointEnt
+}
+
+var logChainMu sync.Mutex
+var logChainHead = make(map[string]*LogChainEnt)
+var integrityKey ed25519.PrivateKey
+
+func resetLogChain() {
+	logChainMu.Lock()
+	defer logChainMu.Unlock()
+	logChainHead = make(map[string]*LogChainEnt)
+}
+
+// CheckLogIntegrityConfig : load Ed25519 key for checkpoints
+func CheckLogIntegrityConfig() error {
+	integrityKey = nil
+	if !Config.LogIntegrity || Config.IntegrityKey == "" {
+		return nil
+	}
+	k, err := loadIntegrityKey(Config.IntegrityKey)
+	if err != nil {
+		return err
+	}
+	integrityKey = k
+	return nil
+}
+
+func loadIntegrityKey(path string) (ed25519.PrivateKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("invalid integrity key %s", path)
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if ek, ok := k.(ed25519.PrivateKey); ok {
+		return ek, nil
+	}
+	return nil, fmt.Errorf("integrity key is not Ed25519 %s", path)
+}
+
+// GenIntegrityKey : generate Ed25519 private key and public key (<path>.pub) for log checkpoints
+func GenIntegrityKey(path string) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("gen integrity key err=%v", err)
+	}
+	b, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		log.Fatalf("gen integrity key err=%v", err)
+	}
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), 0600); err != nil {
+		log.Fatalf("gen integrity key err=%v", err)
+	}
+	b, err = x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		log.Fatalf("gen integrity key err=%v", err)
+	}
+	if err := os.WriteFile(path+".pub", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}), 0644); err != nil {
+		log.Fatalf("gen integrity key err=%v", err)
+	}
+}
+
+func getIntegrityKeyID(pub ed25519.PublicKey) string {
+	h := sha256.Sum256(pub)
+	return hex.EncodeToString(h[:8])
+}
+
+// logChainBuilder makes chain record of a batch in SaveLogs. logChainMu must be locked.
+type logChainBuilder struct {
+	t      string
+	leaves map[string][]byte
+	ent    *LogChainEnt
+}
+
+func newLogChainBuilder(t string) *logChainBuilder {
+	e := &LogChainEnt{Type: t, Seq: 1}
+	if head := getLogChainHead(t); head != nil {
+		e.Seq = head.Seq + 1
+		e.Prev = head.Hash
+	}
+	return &logChainBuilder{
+		t:      t,
+		leaves: make(map[string][]byte),
+		ent:    e,
+	}
+}
+
+func (c *logChainBuilder) add(k string, v []byte, ts int64, ttl time.Duration) {
+	c.leaves[k] = getLogChainLeaf(k, v)
+	e := c.ent
+	if e.Count == 0 || ts < e.Start {
+		e.Start = ts
+	}
+	e.End = max(e.End, ts)
+	exp := time.Now().Add(ttl).UnixNano()
+	if e.Count == 0 || exp < e.Expire {
+		e.Expire = exp
+	}
+	e.Count++
+}
+
+// entries returns chain record, time index and head entries to save with logs.
+func (c *logChainBuilder) entries() ([]*badger.Entry, error) {
+	e := c.ent
+	e.Hash = getLogChainHash(e.Prev, c.leaves)
+	j, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	ttl := getMaxLogRetention(c.t)
+	return []*badger.Entry{
+		badger.NewEntry([]byte(getLogChainKey(c.t, e.Seq)), j).WithTTL(ttl),
+		badger.NewEntry([]byte(getLogChainTimeKey(e)), []byte{}).WithTTL(ttl),
+		badger.NewEntry([]byte("logchainhead:"+c.t), j),
+	}, nil
+}
+
+// done updates cached head after commit.
+func (c *logChainBuilder) done() {
+	logChainHead[c.t] = c.ent
+}
+
+// getLogChainLeaf returns SHA-256 of key and value of log.
+func getLogChainLeaf(k string, v []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(k))
+	h.Write([]byte("\t"))
+	h.Write(v)
+	return h.Sum(nil)
+}
+
+// getLogChainHash returns hash of batch from prev hash and leaves of logs.
+func getLogChainHash(prev string, leaves map[string][]byte) string {
+	keys := make([]string, 0, len(leaves))
+	for k := range leaves {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	h := sha256.New()
+	h.Write([]byte(prev))
+	h.Write([]byte("\n"))
+	for _, k := range keys {
+		h.Write(leaves[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func getLogChainKey(t string, seq int64) string {
+	return fmt.Sprintf("logchain:%s:%016x", t, seq)
+}
+
+func getLogChainTimeKey(e *LogChainEnt) string {
+	return fmt.Sprintf("logchaintime:%s:%016x:%016x", e.Type, e.Start, e.Seq)
+}
+
+// getLogKeySeq returns seq of batch in log key. 0 is not chained.
+func getLogKeySeq(k []byte) int64 {
+	a := strings.SplitN(string(k), ":", 4)
+	if len(a) < 4 {
+		return 0
+	}
+	seq, err := strconv.ParseInt(a[3], 16, 64)
+	if err != nil {
+		return 0
+	}
+	return seq
+}
+
+// getLogChainHead returns last chain record of log type. logChainMu must be locked.
+func getLogChainHead(t string) *LogChainEnt {
+	if h, ok := logChainHead[t]; ok {
+		return h
+	}
+	var h *LogChainEnt
+	db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte("logchainhead:" + t))
+		if err != nil {
+			return nil
+		}
+		return item.Value(func(v []byte) error {
+			var e LogChainEnt
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			h = &e
+			return nil
+		})
+	})
+	logChainHead[t] = h
+	return h
+}
+
+// getLogChainKeyTime returns time of logchaintime:<type>:<time>:<seq> or logcheckpoint:<type>:<time>.
+func getLogChainKeyTime(k []byte) int64 {
+	a := strings.SplitN(string(k), ":", 4)
+	if len(a) < 3 {
+		return 0
+	}
+	ts, err := strconv.ParseInt(a[2], 16, 64)
+	if err != nil {
+		return 0
+	}
+	return ts
+}
+
+// expireLogChain records batches of evicted logs as expired.
+func expireLogChain(t string, seqs map[int64]bool) error {
+	if len(seqs) < 1 {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	return db.Update(func(txn *badger.Txn) error {
+		for seq := range seqs {
+			item, err := txn.Get([]byte(getLogChainKey(t, seq)))
+			if err != nil {
+				continue
+			}
+			var e LogChainEnt
+			if err := item.Value(func(v []byte) error {
+				return json.Unmarshal(v, &e)
+			}); err != nil {
+				return err
+			}
+			if e.Expire <= now {
+				continue
+			}
+			e.Expire = now
+			j, err := json.Marshal(&e)
+			if err != nil {
+				return err
+			}
+			if err := txn.SetEntry(badger.NewEntry(item.KeyCopy(nil), j).WithTTL(getMaxLogRetention(t))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func clearLogChain(t string) {
+	db.DropPrefix([]byte("logchain:"+t+":"), []byte("logchaintime:"+t+":"),
+		[]byte("logchainhead:"+t), []byte("logcheckpoint:"+t+":"))
+	logChainMu.Lock()
+	delete(logChainHead, t)
+	logChainMu.Unlock()
+}
+
+// StartLogIntegrity : seal chain heads with Ed25519 signature periodically
+func StartLogIntegrity(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if !Config.LogIntegrity {
+		return
+	}
+	if integrityKey == nil {
+		log.Printf("log integrity without checkpoint key")
+		return
+	}
+	interval := time.Minute * time.Duration(max(Config.IntegrityCheckpoint, 1))
+	log.Printf("start log integrity checkpoint interval=%v", interval)
+	timer := time.NewTicker(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			SaveLogCheckpoints()
+			log.Printf("stop log integrity")
+			return
+		case <-timer.C:
+			SaveLogCheckpoints()
+		}
+	}
+}
+
+// SaveLogCheckpoints : sign chain heads updated after last checkpoint
+func SaveLogCheckpoints() {
+	if integrityKey == nil {
+		return
+	}
+	for _, t := range logTypes {
+		logChainMu.Lock()
+		h := getLogChainHead(t)
+		logChainMu.Unlock()
+		if h == nil {
+			continue
+		}
+		if last := getLastLogCheckpoint(t); last != nil && last.Seq >= h.Seq {
+			continue
+		}
+		if _, err := saveLogCheckpoint(h); err != nil {
+			log.Printf("save log checkpoint err=%v", err)
+		}
+	}
+}
+
+// saveLogCheckpoint signs chain record and saves checkpoint.
+func saveLogCheckpoint(e *LogChainEnt) (*LogCheckpointEnt, error) {
+	c := &LogCheckpointEnt{
+		Type:  e.Type,
+		Seq:   e.Seq,
+		Hash:  e.Hash,
+		Time:  time.Now().UnixNano(),
+		KeyID: getIntegrityKeyID(integrityKey.Public().(ed25519.PublicKey)),
+	}
+	c.Sig = base64.StdEncoding.EncodeToString(ed25519.Sign(integrityKey, getLogCheckpointMessage(c)))
+	j, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return c, db.Update(func(txn *badger.Txn) error {
+		k := fmt.Sprintf("logcheckpoint:%s:%016x", c.Type, c.Time)
+		return txn.SetEntry(badger.NewEntry([]byte(k), j).WithTTL(getMaxLogRetention(c.Type)))
+	})
+}
+
+func getLogCheckpointMessage(c *LogCheckpointEnt) []byte {
+	return fmt.Appendf(nil, "twlogeye-checkpoint\n%s\n%d\n%s\n%d", c.Type, c.Seq, c.Hash, c.Time)
+}
+
+// verifyLogCheckpoint checks signature of checkpoint.
+func verifyLogCheckpoint(pub ed25519.PublicKey, c *LogCheckpointEnt) bool {
+	sig, err := base64.StdEncoding.DecodeString(c.Sig)
+	return err == nil && c.KeyID == getIntegrityKeyID(pub) && ed25519.Verify(pub, getLogCheckpointMessage(c), sig)
+}
+
+func getLastLogCheckpoint(t string) *LogCheckpointEnt {
+	var ret *LogCheckpointEnt
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.Reverse = true
+		prefix := []byte("logcheckpoint:" + t + ":")
+		opt.Prefix = prefix
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		it.Seek([]byte("logcheckpoint:" + t + ":z"))
+		if it.ValidForPrefix(prefix) {
+			it.Item().Value(func(v []byte) error {
+				var c LogCheckpointEnt
+				if err := json.Unmarshal(v, &c); err == nil {
+					ret = &c
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	return ret
+}
+
+// getLogChainRange returns chain records of seq in [from, to].
+func getLogChainRange(t string, from, to int64) map[int64]*LogChainEnt {
+	ret := make(map[int64]*LogChainEnt)
+	prefix := []byte("logchain:" + t + ":")
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.Prefix = prefix
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Seek([]byte(getLogChainKey(t, from))); it.ValidForPrefix(prefix); it.Next() {
+			var e LogChainEnt
+			if err := it.Item().Value(func(v []byte) error {
+				return json.Unmarshal(v, &e)
+			}); err != nil {
+				continue
+			}
+			if e.Seq > to {
+				break
+			}
+			ret[e.Seq] = &e
+		}
+		return nil
+	})
+	return ret
+}
+
+// getLogChainSeqByTime returns seq of batches started in [st, et].
+func getLogChainSeqByTime(t string, st, et int64) []int64 {
+	ret := []int64{}
+	prefix := []byte("logchaintime:" + t + ":")
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.Prefix = prefix
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Seek(fmt.Appendf(nil, "logchaintime:%s:%016x", t, st)); it.ValidForPrefix(prefix); it.Next() {
+			k := it.Item().Key()
+			if getLogChainKeyTime(k) > et {
+				break
+			}
+			if seq := getLogKeySeq(k); seq > 0 {
+				ret = append(ret, seq)
+			}
+		}
+		return nil
+	})
+	return ret
+}
+
+// getLogChainLeaves returns leaves of logs of batches.
+func getLogChainLeaves(t string, recs map[int64]*LogChainEnt) map[int64]map[string][]byte {
+	ret := make(map[int64]map[string][]byte)
+	if len(recs) < 1 {
+		return ret
+	}
+	ws, we := int64(math.MaxInt64), int64(0)
+	for _, e := range recs {
+		ws = min(ws, e.Start)
+		we = max(we, e.End)
+	}
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte(t + ":")
+		for it.Seek(fmt.Appendf(nil, "%s:%016x", t, ws)); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			ts := getLogKeyTime(item.Key())
+			if ts > we {
+				break
+			}
+			e, ok := recs[getLogKeySeq(item.Key())]
+			if !ok || ts < e.Start || ts > e.End {
+				continue
+			}
+			k := string(item.KeyCopy(nil))
+			item.Value(func(v []byte) error {
+				if ret[e.Seq] == nil {
+					ret[e.Seq] = make(map[string][]byte)
+				}
+				ret[e.Seq][k] = getLogChainLeaf(k, v)
+				return nil
+			})
+		}
+		return nil
+	})
+	return ret
+}
+
+// getLogCheckpoints returns checkpoints in [st, et].
+func getLogCheckpoints(t string, st, et int64) []*LogCheckpointEnt {
+	ret := []*LogCheckpointEnt{}
+	prefix := []byte("logcheckpoint:" + t + ":")
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.Prefix = prefix
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Seek([]byte(fmt.Sprintf("logcheckpoint:%s:%016x", t, st))); it.ValidForPrefix(prefix); it.Next() {
+			if getLogChainKeyTime(it.Item().Key()) > et {
+				break
+			}
+			it.Item().Value(func(v []byte) error {
+				var c LogCheckpointEnt
+				if err := json.Unmarshal(v, &c); err == nil {
+					ret = append(ret, &c)
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	return ret
+}
+
+// logChainVerifier checks links, counts and hashes of chain records.
+type logChainVerifier struct {
+	ret *LogVerifyEnt
+}
+
+func (v *logChainVerifier) issue(kind string, seq, ts int64, format string, a ...any) {
+	v.ret.Issues = append(v.ret.Issues, &LogVerifyIssueEnt{Kind: kind, Seq: seq, Time: ts, Message: fmt.Sprintf(format, a...)})
+}
+
+// checkLinks checks gaps and links of records in [from, to].
+func (v *logChainVerifier) checkLinks(recs map[int64]*LogChainEnt, from, to int64) {
+	var p *LogChainEnt
+	for seq := from; seq <= to; seq++ {
+		e, ok := recs[seq]
+		if !ok {
+			gs := seq
+			for ; seq < to; seq++ {
+				if _, ok := recs[seq+1]; ok {
+					break
+				}
+			}
+			ts := int64(0)
+			if p != nil {
+				ts = p.End
+			}
+			v.issue("gap", gs, ts, "missing batches seq=%d-%d", gs, seq)
+			p = nil
+			continue
+		}
+		if p != nil && e.Prev != p.Hash {
+			v.issue("chain", e.Seq, e.Start, "prev hash mismatch")
+		}
+		p = e
+	}
+}
+
+// checkHash checks count and hash of batch from leaves of logs.
+func (v *logChainVerifier) checkHash(e *LogChainEnt, leaves map[string][]byte) {
+	v.ret.Batches++
+	if v.ret.FirstSeq == 0 || e.Seq < v.ret.FirstSeq {
+		v.ret.FirstSeq = e.Seq
+	}
+	v.ret.LastSeq = max(v.ret.LastSeq, e.Seq)
+	if int64(len(leaves)) != e.Count {
+		v.issue("mismatch", e.Seq, e.Start, "log count %d != %d", len(leaves), e.Count)
+	} else if getLogChainHash(e.Prev, leaves) != e.Hash {
+		v.issue("mismatch", e.Seq, e.Start, "hash mismatch")
+	}
+}
+
+// checkCheckpoint checks signature and hash of checkpoint.
+func (v *logChainVerifier) checkCheckpoint(pub ed25519.PublicKey, c *LogCheckpointEnt, recs map[int64]*LogChainEnt) {
+	v.ret.Checkpoints++
+	if pub == nil {
+		return
+	}
+	if !verifyLogCheckpoint(pub, c) {
+		v.issue("checkpoint", c.Seq, c.Time, "invalid signature key=%s", c.KeyID)
+		return
+	}
+	if e, ok := recs[c.Seq]; ok && e.Hash != c.Hash {
+		v.issue("checkpoint", c.Seq, c.Time, "hash mismatch")
+	}
+}
+
+// VerifyLog : verify hash chain and checkpoints of logs in time range
+func VerifyLog(t string, st, et int64) (*LogVerifyEnt, error) {
+	if !slices.Contains(logTypes, t) {
+		return nil, fmt.Errorf("invalid log type %s", t)
+	}
+	if et == 0 {
+		et = time.Now().UnixNano()
+	}
+	ret := &LogVerifyEnt{Type: t, Issues: []*LogVerifyIssueEnt{}}
+	v := &logChainVerifier{ret: ret}
+	logChainMu.Lock()
+	head := getLogChainHead(t)
+	logChainMu.Unlock()
+	// Batches of logs in time range and batches started in time range
+	seqs := make(map[int64]int64)
+	noSeq := []int64{}
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		prefix := []byte(t + ":")
+		for it.Seek(fmt.Appendf(nil, "%s:%016x", t, st)); it.ValidForPrefix(prefix); it.Next() {
+			k := it.Item().Key()
+			ts := getLogKeyTime(k)
+			if ts > et {
+				break
+			}
+			ret.Logs++
+			seq := getLogKeySeq(k)
+			if seq < 1 {
+				noSeq = append(noSeq, ts)
+			} else if head == nil || seq > head.Seq {
+				v.issue("unchained", seq, ts, "log of unknown batch key=%s", k)
+			} else if _, ok := seqs[seq]; !ok {
+				seqs[seq] = ts
+			}
+		}
+		return nil
+	})
+	for _, seq := range getLogChainSeqByTime(t, st, et) {
+		if _, ok := seqs[seq]; !ok {
+			seqs[seq] = 0
+		}
+	}
+	if len(seqs) < 1 {
+		if head != nil && len(noSeq) > 0 && noSeq[len(noSeq)-1] >= head.Start {
+			v.issue("unchained", 0, noSeq[len(noSeq)-1], "log not in chain")
+		}
+		return ret, nil
+	}
+	from, to := int64(math.MaxInt64), int64(0)
+	for seq := range seqs {
+		from = min(from, seq)
+		to = max(to, seq)
+	}
+	// Record before first batch is only for chain link
+	recs := getLogChainRange(t, max(from-1, 1), to)
+	v.checkLinks(recs, max(from-1, 1), to)
+	if head != nil && head.Seq > to && et >= head.Start && st <= head.End {
+		if _, ok := getLogChainRange(t, to+1, to+1)[to+1]; !ok {
+			v.issue("gap", to+1, head.Start, "missing batches seq=%d-%d", to+1, head.Seq)
+		}
+	}
+	// Hash of batches
+	now := time.Now().UnixNano()
+	check := make(map[int64]*LogChainEnt)
+	firstStart := int64(math.MaxInt64)
+	for seq := range seqs {
+		if e, ok := recs[seq]; ok {
+			firstStart = min(firstStart, e.Start)
+			// Only recorded expire time decides expiry. Deleted logs before it are mismatch.
+			if e.Expire <= now {
+				ret.Expired++
+				continue
+			}
+			check[seq] = e
+		}
+	}
+	leaves := getLogChainLeaves(t, check)
+	for seq := from; seq <= to; seq++ {
+		if e, ok := check[seq]; ok {
+			v.checkHash(e, leaves[seq])
+		}
+	}
+	for _, ts := range noSeq {
+		if ts >= firstStart {
+			v.issue("unchained", 0, ts, "log not in chain")
+		}
+	}
+	// Checkpoints
+	var pub ed25519.PublicKey
+	if integrityKey != nil {
+		pub = integrityKey.Public().(ed25519.PublicKey)
+	}
+	cps := getLogCheckpoints(t, st, et)
+	last := getLastLogCheckpoint(t)
+	if last != nil && (last.Time < st || last.Time > et) {
+		// Last checkpoint signs all batches before it
+		cps = append(cps, last)
+	}
+	for _, c := range cps {
+		if _, ok := recs[c.Seq]; !ok {
+			for k, e := range getLogChainRange(t, c.Seq, c.Seq) {
+				recs[k] = e
+			}
+		}
+		v.checkCheckpoint(pub, c, recs)
+	}
+	// Batches after last checkpoint interval may not be signed yet
+	if e, ok := check[ret.FirstSeq]; ok && (pub == nil || last == nil || last.Seq < e.Seq) &&
+		e.Start < now-int64(time.Minute)*int64(max(Config.IntegrityCheckpoint, 1)) {
+		v.issue("checkpoint", e.Seq, e.Start, "no signed checkpoint for batches seq=%d-%d", e.Seq, ret.LastSeq)
+	}
+	return ret, nil
+}
+
+// getLogChainProof : get chain records of logs of seqs in [st, et) for archive.
+// Last record is signed as checkpoint.
+func getLogChainProof(t string, st, et int64, seqs map[int64]bool) (*LogChainProofEnt, error) {
+	ret := &LogChainProofEnt{Type: t, Start: st, End: et, Chain: []*LogChainEnt{}, Checkpoints: []*LogCheckpointEnt{}}
+	if len(seqs) < 1 {
+		return ret, nil
+	}
+	from, to := int64(math.MaxInt64), int64(0)
+	for seq := range seqs {
+		from = min(from, seq)
+		to = max(to, seq)
+	}
+	recs := getLogChainRange(t, from, to)
+	for seq := from; seq <= to; seq++ {
+		if e, ok := recs[seq]; ok {
+			ret.Chain = append(ret.Chain, e)
+		}
+	}
+	// Leaves of logs of batches not in file
+	check := make(map[int64]*LogChainEnt)
+	for _, e := range ret.Chain {
+		if seqs[e.Seq] || (e.Start < et && e.End >= st) {
+			check[e.Seq] = e
+		}
+	}
+	leaves := getLogChainLeaves(t, check)
+	for seq, e := range check {
+		if int64(len(leaves[seq])) != e.Count {
+			ret.Expired = append(ret.Expired, seq)
+			continue
+		}
+		for k, l := range leaves[seq] {
+			if ts := getLogKeyTime([]byte(k)); ts >= st && ts < et {
+				continue
+			}
+			if ret.Leaves == nil {
+				ret.Leaves = make(map[string]string)
+			}
+			ret.Leaves[k] = hex.EncodeToString(l)
+		}
+	}
+	slices.Sort(ret.Expired)
+	if integrityKey == nil || len(ret.Chain) < 1 {
+		return ret, nil
+	}
+	b, err := x509.MarshalPKIXPublicKey(integrityKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	ret.PublicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))
+	c, err := saveLogCheckpoint(ret.Chain[len(ret.Chain)-1])
+	if err != nil {
+		return nil, err
+	}
+	ret.Checkpoints = append(ret.Checkpoints, c)
+	return ret, nil
+}
+
+// LoadIntegrityPublicKey : load Ed25519 public key (PEM) to verify checkpoints
+func LoadIntegrityPublicKey(b []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("invalid public key")
+	}
+	k, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if pub, ok := k.(ed25519.PublicKey); ok {
+		return pub, nil
+	}
+	return nil, fmt.Errorf("public key is not Ed25519")
+}
+
+// VerifyArchive : verify archived file with chain proof (<path>.chain.json).
+// Public key of configured integrity key is used if pub is nil.
+// Public key in proof is used only if no key is configured and it is reported as untrusted.
+func VerifyArchive(path string, pub ed25519.PublicKey) (*LogVerifyEnt, error) {
+	j, err := os.ReadFile(path + ".chain.json")
+	if err != nil {
+		return nil, err
+	}
+	var p LogChainProofEnt
+	if err := json.Unmarshal(j, &p); err != nil {
+		return nil, err
+	}
+	ret := &LogVerifyEnt{Type: p.Type, Issues: []*LogVerifyIssueEnt{}}
+	v := &logChainVerifier{ret: ret}
+	if pub == nil && Config.IntegrityKey != "" {
+		k, err := loadIntegrityKey(Config.IntegrityKey)
+		if err != nil {
+			return nil, err
+		}
+		pub = k.Public().(ed25519.PublicKey)
+	}
+	if pub == nil && p.PublicKey != "" {
+		// Anyone who rewrites archive can sign proof with own key
+		if pub, err = LoadIntegrityPublicKey([]byte(p.PublicKey)); err != nil {
+			return nil, err
+		}
+		v.issue("untrusted", 0, 0, "public key in proof is not trusted key=%s", getIntegrityKeyID(pub))
+	}
+	recs := make(map[int64]*LogChainEnt)
+	from, to := int64(math.MaxInt64), int64(0)
+	for _, e := range p.Chain {
+		recs[e.Seq] = e
+		from = min(from, e.Seq)
+		to = max(to, e.Seq)
+	}
+	leaves := make(map[int64]map[string][]byte)
+	addLeaf := func(k string, l []byte) {
+		seq := getLogKeySeq([]byte(k))
+		if leaves[seq] == nil {
+			leaves[seq] = make(map[string][]byte)
+		}
+		leaves[seq][k] = l
+	}
+	if err := scanArchiveFile(path, func(l *archiveLogEnt) bool {
+		ret.Logs++
+		e, ok := recs[getLogKeySeq([]byte(l.Key))]
+		if !ok || l.Time < e.Start || l.Time > e.End || getLogKeyTime([]byte(l.Key)) != l.Time {
+			v.issue("unchained", 0, l.Time, "log not in chain key=%s", l.Key)
+			return true
+		}
+		addLeaf(l.Key, getLogChainLeaf(l.Key, []byte(l.Src+"\t"+l.Log)))
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	for k, h := range p.Leaves {
+		l, err := hex.DecodeString(h)
+		if err != nil {
+			return nil, err
+		}
+		if ts := getLogKeyTime([]byte(k)); ts >= p.Start && ts < p.End {
+			v.issue("mismatch", getLogKeySeq([]byte(k)), ts, "log of file in proof key=%s", k)
+			continue
+		}
+		addLeaf(k, l)
+	}
+	if len(p.Checkpoints) < 1 {
+		v.issue("checkpoint", to, 0, "no signed checkpoint")
+	} else if pub == nil {
+		v.issue("checkpoint", to, 0, "no public key to verify checkpoints")
+	}
+	if len(recs) < 1 {
+		return ret, nil
+	}
+	v.checkLinks(recs, from, to)
+	for seq := from; seq <= to; seq++ {
+		e, ok := recs[seq]
+		if !ok || (len(leaves[seq]) < 1 && (e.Start >= p.End || e.End < p.Start)) {
+			// Batch without logs in file is only for chain link
+			continue
+		}
+		if slices.Contains(p.Expired, seq) {
+			ret.Expired++
+			continue
+		}
+		v.checkHash(e, leaves[seq])
+	}
+	for _, c := range p.Checkpoints {
+		v.checkCheckpoint(pub, c, recs)
+	}
+	if pub != nil && len(p.Checkpoints) > 0 && p.Checkpoints[len(p.Checkpoints)-1].Seq != to {
+		v.issue("checkpoint", to, 0, "last batch is not signed")
+	}
+	return ret, nil
+}
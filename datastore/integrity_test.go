@@ -0,0 +1,282 @@
+package datastore
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestLogIntegrity(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.LogIntegrity = true
+	Config.IntegrityKey = filepath.Join(t.TempDir(), "integrity.key")
+	GenIntegrityKey(Config.IntegrityKey)
+	if err := CheckLogIntegrityConfig(); err != nil || integrityKey == nil {
+		t.Fatalf("load integrity key err=%v", err)
+	}
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.LogIntegrity = false
+		Config.IntegrityKey = ""
+		integrityKey = nil
+	}()
+	base := time.Now().Add(-time.Hour).UnixNano()
+	for b := range 3 {
+		logs := []*LogEnt{}
+		for i := range 5 {
+			logs = append(logs, &LogEnt{
+				Time: base + int64(b)*int64(time.Minute) + int64(i)*int64(time.Second),
+				Src:  "192.168.1.1",
+				Log:  fmt.Sprintf(`{"batch":%d,"seq":%d}`, b, i),
+			})
+		}
+		if err := SaveLogs("syslog", logs); err != nil {
+			t.Fatal(err)
+		}
+		if b == 1 {
+			// Head is loaded from DB
+			resetLogChain()
+		}
+	}
+	// Batch overlaps other batches in time like Windows event logs
+	if err := SaveLogs("syslog", []*LogEnt{
+		{Time: base + int64(time.Second)/2, Src: "192.168.1.2", Log: "late 1"},
+		{Time: base + int64(time.Minute) + int64(time.Second)/2, Src: "192.168.1.2", Log: "late 2"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	verify := func() *LogVerifyEnt {
+		r, err := VerifyLog("syslog", 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	r := verify()
+	if len(r.Issues) != 1 || r.Issues[0].Kind != "checkpoint" || r.Batches != 4 || r.Logs != 17 || r.FirstSeq != 1 || r.LastSeq != 4 {
+		t.Fatalf("invalid verify result without checkpoint %+v issues=%v", r, r.Issues)
+	}
+	SaveLogCheckpoints()
+	SaveLogCheckpoints()
+	if r := verify(); len(r.Issues) != 0 || r.Checkpoints != 1 {
+		t.Fatalf("invalid checkpoint %+v issues=%v", r, r.Issues)
+	}
+	// Logs in time range of second batch
+	r, _ = VerifyLog("syslog", base+int64(time.Minute), base+int64(time.Minute)+int64(time.Second)*4)
+	if len(r.Issues) != 0 || r.Batches != 2 || r.Logs != 6 || r.FirstSeq != 2 || r.LastSeq != 4 || r.Checkpoints != 1 {
+		t.Fatalf("invalid verify result in time range %+v issues=%v", r, r.Issues)
+	}
+
+	// Archive with proof
+	Config.ArchiveDir = t.TempDir()
+	e, err := archivePeriod("syslog", base, base+int64(time.Minute))
+	if err != nil || e == nil || e.Proof == "" {
+		t.Fatalf("archive with proof %+v err=%v", e, err)
+	}
+	path := filepath.Join(Config.ArchiveDir, e.File)
+	Config.ArchiveDir = ""
+	var p LogChainProofEnt
+	if j, err := os.ReadFile(path + ".chain.json"); err != nil || json.Unmarshal(j, &p) != nil {
+		t.Fatalf("read proof err=%v", err)
+	}
+	// Batch 1 and 4 have logs in file, batch 4 has a log out of file
+	if len(p.Chain) != 4 || len(p.Leaves) != 1 || len(p.Checkpoints) != 1 || p.Checkpoints[0].Seq != 4 {
+		t.Fatalf("invalid proof %+v", p)
+	}
+	pub, err := LoadIntegrityPublicKey([]byte(p.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err = VerifyArchive(path, pub)
+	if err != nil || len(r.Issues) != 0 || r.Logs != 6 || r.Batches != 2 {
+		t.Fatalf("verify archive %+v err=%v", r, err)
+	}
+	// Modify archived file
+	lines := []*archiveLogEnt{}
+	scanArchiveFile(path, func(l *archiveLogEnt) bool {
+		lines = append(lines, l)
+		return true
+	})
+	writeArchive := func(list []*archiveLogEnt) {
+		f, _ := os.Create(path)
+		w := gzip.NewWriter(f)
+		enc := json.NewEncoder(w)
+		for _, l := range list {
+			enc.Encode(l)
+		}
+		w.Close()
+		f.Close()
+	}
+	hasArchiveIssue := func(kind string) bool {
+		r, err := VerifyArchive(path, pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range r.Issues {
+			if i.Kind == kind {
+				return true
+			}
+		}
+		return false
+	}
+	org := lines[2].Log
+	lines[2].Log = "modified"
+	writeArchive(lines)
+	if !hasArchiveIssue("mismatch") {
+		t.Error("modified archive must be mismatch")
+	}
+	lines[2].Log = org
+	writeArchive(lines[1:])
+	if !hasArchiveIssue("mismatch") {
+		t.Error("deleted log in archive must be mismatch")
+	}
+	writeArchive(lines)
+	if hasArchiveIssue("mismatch") {
+		t.Error("restored archive must be ok")
+	}
+	// Other public key
+	other := filepath.Join(t.TempDir(), "other.key")
+	GenIntegrityKey(other)
+	ob, _ := os.ReadFile(other + ".pub")
+	opub, _ := LoadIntegrityPublicKey(ob)
+	if r, _ := VerifyArchive(path, opub); len(r.Issues) != 1 || r.Issues[0].Kind != "checkpoint" {
+		t.Errorf("other key must be checkpoint error %+v", r)
+	}
+	// Configured key is used without public key
+	if r, _ := VerifyArchive(path, nil); len(r.Issues) != 0 {
+		t.Errorf("verify with configured key %+v", r.Issues)
+	}
+	// Key in proof is not trusted
+	key := Config.IntegrityKey
+	Config.IntegrityKey = ""
+	if r, _ := VerifyArchive(path, nil); len(r.Issues) != 1 || r.Issues[0].Kind != "untrusted" {
+		t.Errorf("key in proof must be untrusted %+v", r)
+	}
+	Config.IntegrityKey = key
+	// Proof without checkpoint
+	np := p
+	np.Checkpoints = []*LogCheckpointEnt{}
+	j, _ := json.Marshal(&np)
+	os.WriteFile(path+".chain.json", j, 0640)
+	if !hasArchiveIssue("checkpoint") {
+		t.Error("proof without checkpoint must be error")
+	}
+
+	set := func(k string, v []byte) {
+		db.Update(func(txn *badger.Txn) error {
+			return txn.Set([]byte(k), v)
+		})
+	}
+	hasIssue := func(kind string, seq int64) bool {
+		for _, i := range verify().Issues {
+			if i.Kind == kind && (seq == 0 || i.Seq == seq) {
+				return true
+			}
+		}
+		return false
+	}
+	// Modify log
+	k := fmt.Sprintf("syslog:%016x:%04x:%016x", base+int64(time.Minute)+int64(time.Second), 1, 2)
+	set(k, []byte("192.168.1.1\t{\"batch\":1,\"seq\":9}"))
+	if !hasIssue("mismatch", 2) {
+		t.Error("modified log must be mismatch")
+	}
+	set(k, []byte("192.168.1.1\t{\"batch\":1,\"seq\":1}"))
+	if r := verify(); len(r.Issues) != 0 {
+		t.Errorf("restored log must be ok %v", r.Issues)
+	}
+	// Insert log out of batches
+	k = fmt.Sprintf("syslog:%016x:%04x", base+int64(time.Second)*30, 0)
+	set(k, []byte("192.168.1.1\t{}"))
+	if !hasIssue("unchained", 0) {
+		t.Error("inserted log must be unchained")
+	}
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(k))
+	})
+	// Delete oldest log is not expiry
+	k = fmt.Sprintf("syslog:%016x:%04x:%016x", base, 0, 1)
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(k))
+	})
+	if !hasIssue("mismatch", 1) {
+		t.Error("deleted oldest log must be mismatch")
+	}
+	set(k, []byte("192.168.1.1\t{\"batch\":0,\"seq\":0}"))
+	if r := verify(); len(r.Issues) != 0 {
+		t.Errorf("restored log must be ok %v", r.Issues)
+	}
+	// Eviction by DB quota is expiry
+	if n, _ := evictOldestLogs("syslog", 1); n != 1 {
+		t.Fatalf("evict oldest log n=%d", n)
+	}
+	if r := verify(); len(r.Issues) != 0 || r.Expired != 1 {
+		t.Errorf("evicted batch must be expired %+v %v", r, r.Issues)
+	}
+	// Forged checkpoint
+	c := getLastLogCheckpoint("syslog")
+	c.Hash = "00"
+	j, _ = json.Marshal(c)
+	set(fmt.Sprintf("logcheckpoint:syslog:%016x", c.Time), j)
+	if !hasIssue("checkpoint", 4) {
+		t.Error("forged checkpoint must be error")
+	}
+	// Delete batch
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(getLogChainKey("syslog", 2)))
+	})
+	if !hasIssue("gap", 2) {
+		t.Error("deleted batch must be gap")
+	}
+	if _, err := VerifyLog("unknown", 0, 0); err == nil {
+		t.Error("unknown log type must be error")
+	}
+	if err := ClearLog("syslog"); err == nil || !strings.Contains(err.Error(), "integrity") {
+		t.Errorf("clear logs must be refused err=%v", err)
+	}
+	if r := verify(); r.Logs != 16 {
+		t.Errorf("logs must not be cleared %+v", r)
+	}
+}
+
+func TestLogIntegrityBigBatch(t *testing.T) {
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.LogIntegrity = true
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.LogIntegrity = false
+	}()
+	// Batch over transaction limit is split into chained batches
+	logs := []*LogEnt{}
+	st := time.Now().Add(-time.Minute).UnixNano()
+	for i := range 40 {
+		logs = append(logs, &LogEnt{Time: st + int64(i), Src: "192.168.1.1", Log: strings.Repeat("x", 400*1024)})
+	}
+	if err := SaveLogs("syslog", logs); err != nil {
+		t.Fatal(err)
+	}
+	r, err := VerifyLog("syslog", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Logs != 40 || r.Batches < 2 || r.LastSeq != r.Batches {
+		t.Errorf("invalid big batch %+v", r)
+	}
+	for _, i := range r.Issues {
+		if i.Kind != "checkpoint" {
+			t.Errorf("invalid big batch issue %+v", i)
+		}
+	}
+}
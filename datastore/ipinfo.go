@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -80,3 +81,52 @@ func GetHostByIP(sip string) string {
 	ip2HostMap.Store(sip, "")
 	return ""
 }
+
+type zoneEnt struct {
+	Net  *net.IPNet
+	Name string
+}
+
+var zoneList []zoneEnt
+
+// LoadZones loads zone map from Config.Zones (CIDR=Zone name).
+func LoadZones() {
+	zoneList = []zoneEnt{}
+	for _, z := range Config.Zones {
+		a := strings.SplitN(z, "=", 2)
+		if len(a) != 2 {
+			log.Printf("invalid zone %s", z)
+			continue
+		}
+		_, n, err := net.ParseCIDR(strings.TrimSpace(a[0]))
+		if err != nil {
+			log.Printf("invalid zone %s err=%v", z, err)
+			continue
+		}
+		zoneList = append(zoneList, zoneEnt{Net: n, Name: strings.TrimSpace(a[1])})
+	}
+	// Longest prefix first
+	sort.SliceStable(zoneList, func(i, j int) bool {
+		li, _ := zoneList[i].Net.Mask.Size()
+		lj, _ := zoneList[j].Net.Mask.Size()
+		return li > lj
+	})
+}
+
+// GetZone returns zone name of IP address.
+// Not matched IP is "Internet" for public address and "Internal" for others.
+func GetZone(sip string) string {
+	ip := net.ParseIP(sip)
+	if ip == nil {
+		return ""
+	}
+	for _, z := range zoneList {
+		if z.Net.Contains(ip) {
+			return z.Name
+		}
+	}
+	if ip.IsGlobalUnicast() && !ip.IsPrivate() {
+		return "Internet"
+	}
+	return "Internal"
+}
@@ -0,0 +1,30 @@
+package datastore
+
+import "testing"
+
+func TestGetZone(t *testing.T) {
+	Config.Zones = []string{
+		"192.168.0.0/16=Office",
+		"192.168.10.0/24=Servers",
+		"invalid",
+		"2001:db8::/32=Lab",
+	}
+	LoadZones()
+	defer func() {
+		Config.Zones = []string{}
+		LoadZones()
+	}()
+	tests := map[string]string{
+		"192.168.1.1":  "Office",
+		"192.168.10.5": "Servers",
+		"10.0.0.1":     "Internal",
+		"8.8.8.8":      "Internet",
+		"2001:db8::1":  "Lab",
+		"not ip":       "",
+	}
+	for ip, zone := range tests {
+		if z := GetZone(ip); z != zone {
+			t.Errorf("GetZone(%s) = %s, want %s", ip, z, zone)
+		}
+	}
+}
@@ -42,7 +42,14 @@ func (t LogType) String() string {
 	return "unknown"
 }
 
-func ClearLog(t string) {
+// Key prefixes of logs
+var logTypes = []string{"syslog", "trap", "netflow", "windows", "otel", "mqtt"}
+
+// ClearLog : clear logs. Logs can not be cleared in log integrity mode.
+func ClearLog(t string) error {
+	if Config.LogIntegrity {
+		return fmt.Errorf("clear logs is disabled in log integrity mode")
+	}
 	switch t {
 	case "syslog":
 	case "netflow":
@@ -51,16 +58,19 @@ func ClearLog(t string) {
 	case "otel":
 	case "mqtt":
 	case "all":
-		db.DropPrefix([]byte("syslog:"))
-		db.DropPrefix([]byte("trap:"))
-		db.DropPrefix([]byte("netflow:"))
-		db.DropPrefix([]byte("windows:"))
-		db.DropPrefix([]byte("otel:"))
-		db.DropPrefix([]byte("mqtt:"))
+		for _, lt := range logTypes {
+			db.DropPrefix([]byte(lt + ":"))
+			clearLogIndex(lt)
+			clearLogChain(lt)
+		}
+		return nil
 	default:
-		return
+		return fmt.Errorf("invalid log type %s", t)
 	}
 	db.DropPrefix([]byte(t + ":"))
+	clearLogIndex(t)
+	clearLogChain(t)
+	return nil
 }
 
 type LogEnt struct {
@@ -72,31 +82,105 @@ type LogEnt struct {
 
 // SaveLogs : save log to database
 func SaveLogs(t string, logs []*LogEnt) error {
+	return saveLogs(t, logs, true)
+}
+
+// saveLogs saves logs. Logs in archived period are marked to archive if markLate.
+// In integrity mode, batch too big for one transaction is split so that
+// each chain record is committed with its logs.
+func saveLogs(t string, logs []*LogEnt, markLate bool) error {
+	err := saveLogBatch(t, logs, markLate)
+	if err == badger.ErrTxnTooBig && len(logs) > 1 {
+		h := len(logs) / 2
+		if err := saveLogs(t, logs[:h], markLate); err != nil {
+			return err
+		}
+		return saveLogs(t, logs[h:], markLate)
+	}
+	return err
+}
+
+func saveLogBatch(t string, logs []*LogEnt, markLate bool) error {
+	lateBefore := int64(0)
+	if markLate {
+		lateBefore = getArchiveLast(t)
+	}
+	var chain *logChainBuilder
+	if Config.LogIntegrity && len(logs) > 0 {
+		logChainMu.Lock()
+		defer logChainMu.Unlock()
+		chain = newLogChainBuilder(t)
+	}
 	txn := db.NewTransaction(true)
-	defer txn.Discard()
-	for i, l := range logs {
-		k := fmt.Sprintf("%s:%016x:%04x", t, l.Time, i)
-		e := badger.NewEntry([]byte(k), []byte(l.Src+"\t"+l.Log)).WithTTL(time.Hour * time.Duration(Config.LogRetention))
+	defer func() {
+		txn.Discard()
+	}()
+	set := func(e *badger.Entry) error {
 		if err := txn.SetEntry(e); err != nil {
-			if err == badger.ErrTxnTooBig {
+			// Logs of chain must not be committed without chain record
+			if err == badger.ErrTxnTooBig && chain == nil {
 				if err := txn.Commit(); err != nil {
 					return err
 				}
 				txn = db.NewTransaction(true)
-				defer txn.Discard()
-				if err := txn.SetEntry(e); err != nil {
-					return err
-				}
-			} else {
+				return txn.SetEntry(e)
+			}
+			return err
+		}
+		return nil
+	}
+	keys := []string{}
+	ttls := []time.Duration{}
+	for i, l := range logs {
+		k := fmt.Sprintf("%s:%016x:%04x", t, l.Time, i)
+		if chain != nil {
+			// Seq of batch to verify logs
+			k += fmt.Sprintf(":%016x", chain.ent.Seq)
+		}
+		v := []byte(l.Src + "\t" + l.Log)
+		ttl := getLogRetention(t, l.Src)
+		if chain != nil {
+			chain.add(k, v, l.Time, ttl)
+		}
+		if err := set(badger.NewEntry([]byte(k), v).WithTTL(ttl)); err != nil {
+			return err
+		}
+		if l.Time < lateBefore {
+			keys = append(keys, k)
+			ttls = append(ttls, ttl)
+		}
+	}
+	if chain != nil {
+		// Chain record is saved with last logs of batch
+		list, err := chain.entries()
+		if err != nil {
+			return err
+		}
+		for _, e := range list {
+			if err := set(e); err != nil {
 				return err
 			}
 		}
 	}
-	return txn.Commit()
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+	if chain != nil {
+		chain.done()
+	}
+	markArchiveLateLogs(t, keys, ttls)
+	return saveLogIndex(t, logs)
 }
 
 // ForEachLogs : for each logs
 func ForEachLog(t string, st, et int64, callBack func(log *LogEnt) bool) {
+	forEachLog(t, st, et, func(k []byte, l *LogEnt) bool {
+		return callBack(l)
+	})
+}
+
+// forEachLog calls callBack with key of log.
+func forEachLog(t string, st, et int64, callBack func(k []byte, log *LogEnt) bool) {
 	if et == 0 {
 		et = time.Now().UnixNano()
 	}
@@ -121,7 +205,7 @@ func ForEachLog(t string, st, et int64, callBack func(log *LogEnt) bool) {
 					})
 					a = strings.SplitN(s, "\t", 2)
 					if len(a) == 2 {
-						if !callBack(&LogEnt{
+						if !callBack(k, &LogEnt{
 							Time: ts,
 							Src:  a[0],
 							Log:  a[1],
@@ -0,0 +1,553 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Log index keys are "logindex:<type>:<kind>:<term>:<bucket>" with empty value.
+// kind is s(source), f(field) or t(token). bucket is start time of time bucket.
+// Index keys have same TTL as logs.
+// "logindexinfo:<type>" has time since index is complete.
+
+const maxLogIndexTerm = 64
+
+type logIndexInfoEnt struct {
+	Start  int64
+	Kinds  []string
+	Bucket int64
+}
+
+var logIndexMu sync.Mutex
+var logIndexInfo = make(map[string]*logIndexInfoEnt)
+
+func resetLogIndexInfo() {
+	logIndexMu.Lock()
+	defer logIndexMu.Unlock()
+	logIndexInfo = make(map[string]*logIndexInfoEnt)
+}
+
+func getLogIndexKinds() []string {
+	ret := []string{}
+	for _, k := range Config.LogIndex {
+		k = strings.TrimSpace(k)
+		if k != "" && !slices.Contains(ret, k) {
+			ret = append(ret, k)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func getLogIndexBucket() int64 {
+	if Config.LogIndexBucket > 0 {
+		return int64(time.Duration(Config.LogIndexBucket) * time.Minute)
+	}
+	return int64(time.Minute * 10)
+}
+
+// getLogIndexInfo returns index info of log type if it is same as config.
+func getLogIndexInfo(t string) *logIndexInfoEnt {
+	logIndexMu.Lock()
+	defer logIndexMu.Unlock()
+	i, ok := logIndexInfo[t]
+	if !ok {
+		db.View(func(txn *badger.Txn) error {
+			item, err := txn.Get([]byte("logindexinfo:" + t))
+			if err != nil {
+				return err
+			}
+			return item.Value(func(v []byte) error {
+				var e logIndexInfoEnt
+				if err := json.Unmarshal(v, &e); err == nil {
+					i = &e
+				}
+				return nil
+			})
+		})
+		logIndexInfo[t] = i
+	}
+	if i == nil || i.Bucket != getLogIndexBucket() || !slices.Equal(i.Kinds, getLogIndexKinds()) {
+		return nil
+	}
+	return i
+}
+
+func setLogIndexInfo(t string, i *logIndexInfoEnt) {
+	logIndexMu.Lock()
+	defer logIndexMu.Unlock()
+	logIndexInfo[t] = i
+	k := []byte("logindexinfo:" + t)
+	db.Update(func(txn *badger.Txn) error {
+		if i == nil {
+			return txn.Delete(k)
+		}
+		j, err := json.Marshal(i)
+		if err != nil {
+			return err
+		}
+		return txn.Set(k, j)
+	})
+}
+
+// updateLogIndexInfo starts index of log type or stops it by config.
+func updateLogIndexInfo(t string, st int64) bool {
+	kinds := getLogIndexKinds()
+	if getLogIndexInfo(t) != nil {
+		return true
+	}
+	if len(kinds) < 1 {
+		logIndexMu.Lock()
+		i := logIndexInfo[t]
+		logIndexMu.Unlock()
+		if i != nil {
+			setLogIndexInfo(t, nil)
+		}
+		return false
+	}
+	setLogIndexInfo(t, &logIndexInfoEnt{Start: st, Kinds: kinds, Bucket: getLogIndexBucket()})
+	log.Printf("start log index %s kinds=%s", t, strings.Join(kinds, ","))
+	return true
+}
+
+// getLogIndexTerms returns index terms ("<kind>:<term>") of log.
+func getLogIndexTerms(l *LogEnt, kinds []string) []string {
+	ret := []string{}
+	var m map[string]any
+	for _, k := range kinds {
+		switch k {
+		case "src":
+			if s := normalizeLogIndexValue(l.Src); s != "" && len(s) <= maxLogIndexTerm {
+				ret = append(ret, "s:"+s)
+			}
+		case "token":
+			for _, tk := range getLogTokens(l.Log) {
+				ret = append(ret, "t:"+tk)
+			}
+		default:
+			if m == nil {
+				m = make(map[string]any)
+				_ = json.Unmarshal([]byte(l.Log), &m)
+			}
+			v, ok := getQueryField(m, k)
+			if !ok {
+				continue
+			}
+			vals, ok := v.([]any)
+			if !ok {
+				vals = []any{v}
+			}
+			for _, v := range vals {
+				switch v.(type) {
+				case map[string]any, []any:
+					continue
+				}
+				if s := normalizeLogIndexValue(queryValueString(v)); len(s) <= maxLogIndexTerm {
+					ret = append(ret, "f:"+k+"="+s)
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// getLogTokens splits text to lower case words.
+func getLogTokens(s string) []string {
+	ret := []string{}
+	for _, tk := range strings.FieldsFunc(strings.ToLower(s), isNotLogTokenChar) {
+		if len(tk) <= maxLogIndexTerm && !slices.Contains(ret, tk) {
+			ret = append(ret, tk)
+		}
+	}
+	return ret
+}
+
+// getInnerLogTokens returns words of text which have separators at both sides in text.
+// Text matches a part of log, so only these words are sure to be words of matched logs.
+func getInnerLogTokens(s string) []string {
+	ret := []string{}
+	r := []rune(strings.ToLower(s))
+	for i := 0; i < len(r); {
+		if isNotLogTokenChar(r[i]) {
+			i++
+			continue
+		}
+		st := i
+		for i < len(r) && !isNotLogTokenChar(r[i]) {
+			i++
+		}
+		if tk := string(r[st:i]); st > 0 && i < len(r) && len(tk) <= maxLogIndexTerm && !slices.Contains(ret, tk) {
+			ret = append(ret, tk)
+		}
+	}
+	return ret
+}
+
+func isNotLogTokenChar(r rune) bool {
+	return !(r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func normalizeLogIndexValue(s string) string {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return strings.ToLower(s)
+}
+
+func getLogIndexKey(t, term string, ts, bucket int64) string {
+	return fmt.Sprintf("logindex:%s:%s:%016x", t, term, ts-ts%bucket)
+}
+
+// saveLogIndex saves index of logs.
+func saveLogIndex(t string, logs []*LogEnt) error {
+	if len(logs) < 1 || !updateLogIndexInfo(t, logs[0].Time) {
+		return nil
+	}
+	kinds := getLogIndexKinds()
+	bucket := getLogIndexBucket()
+	// Index key must not expire before logs in bucket
+	ttl := getMaxLogRetention(t)
+	done := make(map[string]bool)
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, l := range logs {
+		for _, term := range getLogIndexTerms(l, kinds) {
+			k := getLogIndexKey(t, term, l.Time, bucket)
+			if done[k] {
+				continue
+			}
+			done[k] = true
+			if err := wb.SetEntry(badger.NewEntry([]byte(k), nil).WithTTL(ttl)); err != nil {
+				return err
+			}
+		}
+	}
+	return wb.Flush()
+}
+
+// clearLogIndex deletes index of log type.
+func clearLogIndex(t string) {
+	setLogIndexInfo(t, nil)
+	db.DropPrefix([]byte("logindex:" + t + ":"))
+}
+
+// deleteLogIndexBefore deletes index keys of buckets which end before et.
+func deleteLogIndexBefore(t string, et int64) error {
+	bucket := getLogIndexBucket()
+	keys := [][]byte{}
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		p := []byte("logindex:" + t + ":")
+		for it.Seek(p); it.ValidForPrefix(p); it.Next() {
+			k := it.Item().Key()
+			if len(k) < 17 || k[len(k)-17] != ':' {
+				continue
+			}
+			b, err := strconv.ParseInt(string(k[len(k)-16:]), 16, 64)
+			if err != nil {
+				continue
+			}
+			if b+bucket <= et {
+				keys = append(keys, it.Item().KeyCopy(nil))
+			}
+		}
+		return nil
+	})
+	if len(keys) < 1 {
+		return nil
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, k := range keys {
+		if err := wb.Delete(k); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// RebuildLogIndex : rebuild index of existing logs
+func RebuildLogIndex(t string) (int, error) {
+	if t == "all" {
+		count := 0
+		for _, lt := range logTypes {
+			c, err := RebuildLogIndex(lt)
+			count += c
+			if err != nil {
+				return count, err
+			}
+		}
+		return count, nil
+	}
+	if !slices.Contains(logTypes, t) {
+		return 0, fmt.Errorf("invalid log type %s", t)
+	}
+	clearLogIndex(t)
+	kinds := getLogIndexKinds()
+	if len(kinds) < 1 {
+		return 0, nil
+	}
+	bucket := getLogIndexBucket()
+	count := 0
+	done := make(map[string]uint64)
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte(t + ":")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			a := strings.SplitN(string(item.Key()), ":", 3)
+			if len(a) != 3 {
+				continue
+			}
+			ts, err := strconv.ParseInt(a[1], 16, 64)
+			if err != nil {
+				continue
+			}
+			var s string
+			item.Value(func(v []byte) error {
+				s = string(v)
+				return nil
+			})
+			a = strings.SplitN(s, "\t", 2)
+			if len(a) != 2 {
+				continue
+			}
+			for _, term := range getLogIndexTerms(&LogEnt{Time: ts, Src: a[0], Log: a[1]}, kinds) {
+				// Index key expires with the last log in bucket
+				k := getLogIndexKey(t, term, ts, bucket)
+				if exp, ok := done[k]; ok && exp >= item.ExpiresAt() {
+					continue
+				}
+				done[k] = item.ExpiresAt()
+				e := badger.NewEntry([]byte(k), nil)
+				e.ExpiresAt = item.ExpiresAt()
+				if err := wb.SetEntry(e); err != nil {
+					return err
+				}
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+	if err := wb.Flush(); err != nil {
+		return count, err
+	}
+	setLogIndexInfo(t, &logIndexInfoEnt{Start: 0, Kinds: kinds, Bucket: bucket})
+	return count, nil
+}
+
+// getIndexedBuckets returns buckets of log type which may have logs matched to query.
+// It returns nil if index can not be used.
+func (q *LogQuery) getIndexedBuckets(t string, i *logIndexInfoEnt, st, et int64) map[int64]bool {
+	if q == nil || q.root == nil {
+		return nil
+	}
+	return planLogIndex(q.root, t, i, st, et)
+}
+
+func planLogIndex(n queryNode, t string, i *logIndexInfoEnt, st, et int64) map[int64]bool {
+	switch x := n.(type) {
+	case queryAnd:
+		var ret map[int64]bool
+		for _, c := range x {
+			b := planLogIndex(c, t, i, st, et)
+			if b == nil {
+				continue
+			}
+			if ret == nil {
+				ret = b
+				continue
+			}
+			for k := range ret {
+				if !b[k] {
+					delete(ret, k)
+				}
+			}
+		}
+		return ret
+	case queryOr:
+		ret := make(map[int64]bool)
+		for _, c := range x {
+			b := planLogIndex(c, t, i, st, et)
+			if b == nil {
+				return nil
+			}
+			for k := range b {
+				ret[k] = true
+			}
+		}
+		return ret
+	case *queryTerm:
+		terms := x.getIndexTerms(i.Kinds)
+		if terms == nil {
+			return nil
+		}
+		var ret map[int64]bool
+		for _, term := range terms {
+			b := getLogIndexBuckets(t, term, i.Bucket, st, et)
+			if ret == nil {
+				ret = b
+				continue
+			}
+			for k := range ret {
+				if !b[k] {
+					delete(ret, k)
+				}
+			}
+		}
+		return ret
+	}
+	return nil
+}
+
+// getIndexTerms returns index terms which all logs matched to term have.
+func (t *queryTerm) getIndexTerms(kinds []string) []string {
+	switch {
+	case t.Op == "":
+		if !t.word || !slices.Contains(kinds, "token") {
+			return nil
+		}
+		ret := []string{}
+		for _, tk := range getInnerLogTokens(t.Value) {
+			ret = append(ret, "t:"+tk)
+		}
+		if len(ret) < 1 {
+			return nil
+		}
+		return ret
+	case t.Op != ":" && t.Op != "=":
+		return nil
+	case t.Op == ":" && (t.exists || t.ipnet != nil || t.lo != "" || !t.plain):
+		return nil
+	}
+	v := normalizeLogIndexValue(t.Value)
+	if len(v) > maxLogIndexTerm {
+		return nil
+	}
+	if t.Field == "src" {
+		if !slices.Contains(kinds, "src") {
+			return nil
+		}
+		return []string{"s:" + v}
+	}
+	if !slices.Contains(kinds, t.Field) {
+		return nil
+	}
+	return []string{"f:" + t.Field + "=" + v}
+}
+
+func getLogIndexBuckets(t, term string, bucket, st, et int64) map[int64]bool {
+	ret := make(map[int64]bool)
+	prefix := "logindex:" + t + ":" + term + ":"
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		p := []byte(prefix)
+		for it.Seek([]byte(fmt.Sprintf("%s%016x", prefix, st-st%bucket))); it.ValidForPrefix(p); it.Next() {
+			k := it.Item().Key()
+			if len(k) != len(prefix)+16 {
+				continue
+			}
+			b, err := strconv.ParseInt(string(k[len(prefix):]), 16, 64)
+			if err != nil {
+				continue
+			}
+			if b > et {
+				break
+			}
+			ret[b] = true
+		}
+		return nil
+	})
+	return ret
+}
+
+// SearchLog : for each logs matched to query. Index is used if possible.
+// Archived logs which are not in DB are searched too if archive is enabled.
+func SearchLog(t string, st, et int64, q *LogQuery, callBack func(log *LogEnt) bool) {
+	if et == 0 {
+		et = time.Now().UnixNano()
+	}
+	cb := func(l *LogEnt) bool {
+		if !q.Match(l) {
+			return true
+		}
+		return callBack(l)
+	}
+	if Config.ArchiveDir != "" {
+		searchLogWithArchive(t, st, et, q, cb)
+		return
+	}
+	searchHotLog(t, st, et, q, cb)
+}
+
+// searchHotLog searches logs in DB with index. It returns false if callback stops search.
+func searchHotLog(t string, st, et int64, q *LogQuery, cb func(log *LogEnt) bool) bool {
+	return searchHotLogKey(t, st, et, q, func(_ []byte, l *LogEnt) bool {
+		return cb(l)
+	})
+}
+
+// searchHotLogKey is searchHotLog with key of log.
+func searchHotLogKey(t string, st, et int64, q *LogQuery, cb func(k []byte, log *LogEnt) bool) bool {
+	stop := false
+	f := func(k []byte, l *LogEnt) bool {
+		if !cb(k, l) {
+			stop = true
+			return false
+		}
+		return true
+	}
+	i := getLogIndexInfo(t)
+	if i == nil || et < i.Start {
+		forEachLog(t, st, et, f)
+		return !stop
+	}
+	is := max(st, i.Start)
+	buckets := q.getIndexedBuckets(t, i, is, et)
+	if buckets == nil {
+		forEachLog(t, st, et, f)
+		return !stop
+	}
+	if st < i.Start {
+		forEachLog(t, st, i.Start-1, f)
+	}
+	list := []int64{}
+	for b := range buckets {
+		list = append(list, b)
+	}
+	slices.Sort(list)
+	for _, b := range list {
+		if stop {
+			return false
+		}
+		bs := max(b, is)
+		be := min(b+i.Bucket-1, et)
+		if bs > be {
+			continue
+		}
+		forEachLog(t, bs, be, f)
+	}
+	return !stop
+}
@@ -0,0 +1,152 @@
+package datastore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLogIndex(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.LogIndex = nil
+	Config.LogIndexBucket = 1
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.LogIndex = nil
+	}()
+
+	base := time.Now().Add(-time.Hour).UnixNano()
+	base -= base % int64(time.Minute)
+	mkLog := func(min int, host, tag, msg string) *LogEnt {
+		return &LogEnt{
+			Time: base + int64(time.Minute)*int64(min) + 1,
+			Src:  "192.168.1." + host[len(host)-1:],
+			Log:  fmt.Sprintf(`{"hostname":"%s","tag":"%s","severity":%d,"content":"%s"}`, host, tag, min%8, msg),
+		}
+	}
+	// Logs before index
+	SaveLogs("syslog", []*LogEnt{
+		mkLog(0, "fw1", "sshd", "Failed password for root"),
+		mkLog(1, "web2", "cron", "run-parts /etc/cron.hourly"),
+	})
+	if getLogIndexInfo("syslog") != nil {
+		t.Fatal("index must be disabled")
+	}
+	Config.LogIndex = []string{"token", "src", "hostname"}
+	SaveLogs("syslog", []*LogEnt{
+		mkLog(10, "fw1", "sshd", "Accepted password for admin"),
+		mkLog(11, "web2", "cron", "run-parts /etc/cron.daily"),
+	})
+	SaveLogs("syslog", []*LogEnt{
+		mkLog(20, "fw3", "sshd", "Failed password for admin"),
+		mkLog(30, "web2", "nginx", "GET /index.html 200"),
+	})
+	i := getLogIndexInfo("syslog")
+	if i == nil || i.Start != base+int64(time.Minute)*10+1 {
+		t.Fatalf("invalid index info %+v", i)
+	}
+	check := func(q string, want int) {
+		lq, err := ParseLogQuery(q)
+		if err != nil {
+			t.Fatalf("parse %q err=%v", q, err)
+		}
+		full := 0
+		ForEachLog("syslog", 0, 0, func(l *LogEnt) bool {
+			if lq.Match(l) {
+				full++
+			}
+			return true
+		})
+		got := 0
+		SearchLog("syslog", 0, 0, lq, func(l *LogEnt) bool {
+			got++
+			return true
+		})
+		if got != want || full != want {
+			t.Errorf("query %q got %d full scan %d want %d", q, got, full, want)
+		}
+	}
+	for _, tc := range []struct {
+		q    string
+		want int
+	}{
+		{"", 6},
+		{"failed", 2},
+		{"fail", 2},
+		{`"failed password"`, 2},
+		{`"password for admin"`, 2},
+		{"admin", 2},
+		{"hostname:fw1", 2},
+		{"hostname=FW3", 0},
+		{"hostname:fw* AND failed", 2},
+		{"src:192.168.1.2 OR nginx", 3},
+		{`src:192.168.1.2 OR "GET /index.html 200"`, 3},
+		{"src=192.168.1.3 password", 1},
+		{"NOT cron", 4},
+		{"tag:cron", 2},
+		{"severity=2", 1},
+		{"daily OR hourly", 2},
+	} {
+		check(tc.q, tc.want)
+	}
+	// Only words with separators at both sides in text use index
+	q, _ := ParseLogQuery(`"password for admin" hostname:fw*`)
+	if b := q.getIndexedBuckets("syslog", i, i.Start, time.Now().UnixNano()); len(b) != 2 {
+		t.Errorf("invalid buckets %v", b)
+	}
+	q, _ = ParseLogQuery(`src:192.168.1.2 OR "GET /index.html 200"`)
+	if b := q.getIndexedBuckets("syslog", i, i.Start, time.Now().UnixNano()); len(b) != 2 {
+		t.Errorf("invalid buckets %v", b)
+	}
+	q, _ = ParseLogQuery("failed")
+	if b := q.getIndexedBuckets("syslog", i, i.Start, time.Now().UnixNano()); b != nil {
+		t.Errorf("text must not use index %v", b)
+	}
+	q, _ = ParseLogQuery("NOT cron")
+	if b := q.getIndexedBuckets("syslog", i, i.Start, time.Now().UnixNano()); b != nil {
+		t.Errorf("NOT must not use index %v", b)
+	}
+	// Stop search in callback
+	n := 0
+	SearchLog("syslog", 0, 0, q, func(l *LogEnt) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("search must stop %d", n)
+	}
+
+	// Rebuild
+	count, err := RebuildLogIndex("syslog")
+	if err != nil || count != 6 {
+		t.Fatalf("rebuild count=%d err=%v", count, err)
+	}
+	if i := getLogIndexInfo("syslog"); i == nil || i.Start != 0 {
+		t.Fatalf("invalid index info after rebuild %+v", i)
+	}
+	q, _ = ParseLogQuery(`"failed password for"`)
+	if b := q.getIndexedBuckets("syslog", getLogIndexInfo("syslog"), 0, time.Now().UnixNano()); len(b) != 3 {
+		t.Errorf("invalid buckets after rebuild %v", b)
+	}
+	check(`"failed password for"`, 2)
+	check("hostname:web2", 3)
+	if _, err := RebuildLogIndex("unknown"); err == nil {
+		t.Error("unknown log type must be error")
+	}
+
+	// Config change stops index until rebuild
+	Config.LogIndex = []string{"token"}
+	if getLogIndexInfo("syslog") != nil {
+		t.Error("index of old config must not be used")
+	}
+	check("hostname:fw1", 2)
+	Config.LogIndex = []string{"token", "src", "hostname"}
+
+	ClearLog("syslog")
+	if getLogIndexInfo("syslog") != nil || len(getLogIndexBuckets("syslog", "t:failed", i.Bucket, 0, time.Now().UnixNano())) != 0 {
+		t.Error("index must be cleared")
+	}
+}
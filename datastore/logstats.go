@@ -0,0 +1,119 @@
+package datastore
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Max number of group and time bucket pairs of log stats
+const maxLogStats = 100000
+
+// LogStatsEnt : count and stats of numeric fields per group per time bucket
+type LogStatsEnt struct {
+	Time  int64
+	Group []string
+	Count int64
+	Stats []*LogFieldStatsEnt
+}
+
+// LogFieldStatsEnt : stats of numeric field
+type LogFieldStatsEnt struct {
+	Field string
+	Count int64
+	Sum   float64
+	Avg   float64
+	Min   float64
+	Max   float64
+}
+
+// GetLogStats : aggregate logs matched to query by group fields and time bucket.
+// interval 0 is one bucket for whole time range.
+func GetLogStats(t string, st, et int64, q *LogQuery, groupBy []string, interval time.Duration, fields []string) ([]*LogStatsEnt, error) {
+	if interval < 0 {
+		return nil, fmt.Errorf("invalid interval %v", interval)
+	}
+	m := make(map[string]*LogStatsEnt)
+	var err error
+	SearchLog(t, st, et, q, func(l *LogEnt) bool {
+		d := &queryData{l: l}
+		bt := st
+		if interval > 0 {
+			bt = l.Time - l.Time%int64(interval)
+		}
+		group := []string{}
+		for _, g := range groupBy {
+			v := ""
+			if vals := d.values(g); len(vals) == 1 {
+				v = queryValueString(vals[0])
+			} else if len(vals) > 1 {
+				v = queryValueString(vals)
+			}
+			group = append(group, v)
+		}
+		k := fmt.Sprintf("%016x\t%s", bt, strings.Join(group, "\t"))
+		e, ok := m[k]
+		if !ok {
+			if len(m) >= maxLogStats {
+				err = fmt.Errorf("too many groups over %d", maxLogStats)
+				return false
+			}
+			e = &LogStatsEnt{Time: bt, Group: group}
+			for _, f := range fields {
+				e.Stats = append(e.Stats, &LogFieldStatsEnt{Field: f, Min: math.Inf(1), Max: math.Inf(-1)})
+			}
+			m[k] = e
+		}
+		e.Count++
+		for _, s := range e.Stats {
+			for _, v := range d.values(s.Field) {
+				f, ok := getLogStatsNumber(v)
+				if !ok {
+					continue
+				}
+				s.Count++
+				s.Sum += f
+				s.Min = min(s.Min, f)
+				s.Max = max(s.Max, f)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ret := []*LogStatsEnt{}
+	for _, k := range keys {
+		e := m[k]
+		for _, s := range e.Stats {
+			if s.Count > 0 {
+				s.Avg = s.Sum / float64(s.Count)
+			} else {
+				s.Min = 0
+				s.Max = 0
+			}
+		}
+		ret = append(ret, e)
+	}
+	return ret, nil
+}
+
+func getLogStatsNumber(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case string:
+		if f, err := strconv.ParseFloat(x, 64); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
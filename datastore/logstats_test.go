@@ -0,0 +1,54 @@
+package datastore
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetLogStats(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	OpenDB()
+	defer CloseDB()
+
+	base := time.Now().Add(-time.Hour * 3).Truncate(time.Hour).UnixNano()
+	hour := int64(time.Hour)
+	SaveLogs("netflow", []*LogEnt{
+		{Time: base + 1, Src: "10.0.0.1", Log: `{"srcAddr":"192.168.1.1","dstPort":22,"bytes":100}`},
+		{Time: base + 2, Src: "10.0.0.1", Log: `{"srcAddr":"192.168.1.1","dstPort":22,"bytes":300}`},
+		{Time: base + 3, Src: "10.0.0.1", Log: `{"srcAddr":"192.168.1.2","dstPort":443,"bytes":"50"}`},
+		{Time: base + hour + 1, Src: "10.0.0.2", Log: `{"srcAddr":"192.168.1.1","dstPort":22,"bytes":500}`},
+		{Time: base + hour + 2, Src: "10.0.0.2", Log: `{"srcAddr":"192.168.1.1","dstPort":22}`},
+	})
+	q, _ := ParseLogQuery("dstPort=22")
+	list, err := GetLogStats("netflow", 0, 0, q, []string{"srcAddr", "src"}, time.Hour, []string{"bytes"})
+	if err != nil {
+		t.Fatalf("get log stats err=%v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("invalid stats len=%d", len(list))
+	}
+	e := list[0]
+	if e.Time != base || e.Group[0] != "192.168.1.1" || e.Group[1] != "10.0.0.1" || e.Count != 2 {
+		t.Errorf("invalid stats %+v", e)
+	}
+	if s := e.Stats[0]; s.Count != 2 || s.Sum != 400 || s.Avg != 200 || s.Min != 100 || s.Max != 300 {
+		t.Errorf("invalid field stats %+v", s)
+	}
+	e = list[1]
+	if e.Time != base+hour || e.Group[1] != "10.0.0.2" || e.Count != 2 || e.Stats[0].Count != 1 || e.Stats[0].Avg != 500 {
+		t.Errorf("invalid stats %+v %+v", e, e.Stats[0])
+	}
+	// Whole time range without group
+	list, err = GetLogStats("netflow", base, 0, nil, nil, 0, []string{"bytes", "none"})
+	if err != nil || len(list) != 1 {
+		t.Fatalf("get log stats len=%d err=%v", len(list), err)
+	}
+	if e := list[0]; e.Time != base || e.Count != 5 || e.Stats[0].Sum != 950 || e.Stats[0].Min != 50 || e.Stats[1].Count != 0 || e.Stats[1].Max != 0 {
+		t.Errorf("invalid stats %+v %+v", e, e.Stats[0])
+	}
+	if _, err := GetLogStats("netflow", 0, 0, nil, nil, -time.Second, nil); err == nil {
+		t.Error("negative interval must be error")
+	}
+}
@@ -0,0 +1,224 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Max number of value log files rewritten by one maintenance
+const maxDBGCCount = 100
+
+// DBMaintenanceEnt : result of value log GC and compaction
+type DBMaintenanceEnt struct {
+	Time     int64
+	Duration int64
+	Flatten  bool
+	GCCount  int64
+	Before   int64
+	After    int64
+	Error    string `json:",omitempty"`
+}
+
+// DBStatsEnt : LSM and value log size, usage per key prefix and last maintenance
+type DBStatsEnt struct {
+	LSMSize  int64
+	VlogSize int64
+	Usage    []*DBUsageEnt
+	LastGC   *DBMaintenanceEnt
+}
+
+var dbMaintenanceMu sync.Mutex
+var dbCompactRequested atomic.Bool
+var lastDBMaintenance *DBMaintenanceEnt
+var lastDBMaintenanceMu sync.Mutex
+
+// StartDBMaintenance : run value log GC and compaction periodically
+func StartDBMaintenance(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if Config.DBPath == "" || (Config.DBGCInterval < 1 && Config.DBCompactInterval < 1 && Config.DBQuota < 1) {
+		log.Printf("db maintenance disabled")
+		return
+	}
+	log.Printf("start db maintenance")
+	timer := time.NewTicker(time.Minute)
+	defer timer.Stop()
+	lastGC := time.Now()
+	lastCompact := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("stop db maintenance")
+			return
+		case <-timer.C:
+			now := time.Now()
+			if !inDBQuietHours(Config.DBGCQuietHours, now) {
+				continue
+			}
+			switch checkDBMaintenanceJob(now, lastGC, lastCompact) {
+			case dbMaintenanceCompact:
+				// Compaction runs GC too
+				dbCompactRequested.Store(false)
+				lastCompact = now
+				lastGC = now
+				RunDBMaintenance(true)
+			case dbMaintenanceGC:
+				lastGC = now
+				RunDBMaintenance(false)
+			}
+		}
+	}
+}
+
+const (
+	dbMaintenanceNone = iota
+	dbMaintenanceGC
+	dbMaintenanceCompact
+)
+
+// RequestDBCompaction : request compaction on next DB maintenance in quiet hours
+func RequestDBCompaction() {
+	dbCompactRequested.Store(true)
+}
+
+// checkDBMaintenanceJob returns job to run now. Each job is gated on its own interval.
+// Requested compaction is run without interval.
+func checkDBMaintenanceJob(now, lastGC, lastCompact time.Time) int {
+	if dbCompactRequested.Load() {
+		return dbMaintenanceCompact
+	}
+	if Config.DBCompactInterval > 0 && now.Sub(lastCompact) >= time.Hour*time.Duration(Config.DBCompactInterval) {
+		return dbMaintenanceCompact
+	}
+	if Config.DBGCInterval > 0 && now.Sub(lastGC) >= time.Minute*time.Duration(Config.DBGCInterval) {
+		return dbMaintenanceGC
+	}
+	return dbMaintenanceNone
+}
+
+// RunDBMaintenance : flatten LSM tree (if flatten) and run value log GC until nothing to rewrite
+func RunDBMaintenance(flatten bool) (*DBMaintenanceEnt, error) {
+	dbMaintenanceMu.Lock()
+	defer dbMaintenanceMu.Unlock()
+	st := time.Now()
+	r := &DBMaintenanceEnt{
+		Time:    st.UnixNano(),
+		Flatten: flatten,
+		Before:  GetDBSize(),
+	}
+	err := runDBMaintenance(r, flatten)
+	if err != nil {
+		r.Error = err.Error()
+	}
+	r.After = GetDBSize()
+	r.Duration = time.Since(st).Nanoseconds()
+	lastDBMaintenanceMu.Lock()
+	lastDBMaintenance = r
+	lastDBMaintenanceMu.Unlock()
+	log.Printf("db maintenance flatten=%v gc=%d before=%d after=%d dur=%v err=%v", flatten, r.GCCount, r.Before, r.After, time.Duration(r.Duration), err)
+	return r, err
+}
+
+func runDBMaintenance(r *DBMaintenanceEnt, flatten bool) error {
+	if db == nil {
+		return fmt.Errorf("db not open")
+	}
+	if flatten {
+		if err := db.Flatten(2); err != nil {
+			return err
+		}
+	}
+	if Config.DBPath == "" {
+		// Value log GC is not supported in memory mode
+		return nil
+	}
+	ratio := Config.DBGCDiscardRatio
+	if ratio <= 0 || ratio >= 1 {
+		ratio = 0.5
+	}
+	for r.GCCount < maxDBGCCount {
+		if err := db.RunValueLogGC(ratio); err != nil {
+			if errors.Is(err, badger.ErrNoRewrite) || errors.Is(err, badger.ErrRejected) {
+				return nil
+			}
+			return err
+		}
+		r.GCCount++
+	}
+	return nil
+}
+
+// GetLastDBMaintenance : get result of last DB maintenance (nil if not run)
+func GetLastDBMaintenance() *DBMaintenanceEnt {
+	lastDBMaintenanceMu.Lock()
+	defer lastDBMaintenanceMu.Unlock()
+	if lastDBMaintenance == nil {
+		return nil
+	}
+	r := *lastDBMaintenance
+	return &r
+}
+
+// GetDBSizes : get LSM tree size and value log size
+func GetDBSizes() (int64, int64) {
+	if db == nil {
+		return 0, 0
+	}
+	return db.Size()
+}
+
+// GetDBStats : get DB health stats
+func GetDBStats() *DBStatsEnt {
+	r := &DBStatsEnt{
+		Usage:  GetDBUsage(),
+		LastGC: GetLastDBMaintenance(),
+	}
+	r.LSMSize, r.VlogSize = GetDBSizes()
+	return r
+}
+
+// CheckDBQuietHours : check quiet hours like "01:00-05:00"
+func CheckDBQuietHours(s string) error {
+	_, _, err := parseDBQuietHours(s)
+	return err
+}
+
+func parseDBQuietHours(s string) (int, int, error) {
+	a := strings.SplitN(strings.TrimSpace(s), "-", 2)
+	if len(a) != 2 {
+		return 0, 0, fmt.Errorf("invalid quiet hours %s", s)
+	}
+	st, err := time.Parse("15:04", strings.TrimSpace(a[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid quiet hours %s", s)
+	}
+	et, err := time.Parse("15:04", strings.TrimSpace(a[1]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid quiet hours %s", s)
+	}
+	return st.Hour()*60 + st.Minute(), et.Hour()*60 + et.Minute(), nil
+}
+
+// inDBQuietHours checks time is in quiet hours. Empty is always quiet.
+// Range over midnight like 23:00-05:00 is supported.
+func inDBQuietHours(s string, now time.Time) bool {
+	if s == "" {
+		return true
+	}
+	st, et, err := parseDBQuietHours(s)
+	if err != nil {
+		return true
+	}
+	m := now.Hour()*60 + now.Minute()
+	if st <= et {
+		return m >= st && m < et
+	}
+	return m >= st || m < et
+}
@@ -0,0 +1,108 @@
+package datastore
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDBMaintenance(t *testing.T) {
+	Config.DBPath = t.TempDir()
+	Config.LogRetention = 24
+	Config.DBGCDiscardRatio = 0.5
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.DBPath = ""
+	}()
+	lastDBMaintenance = nil
+	logs := []*LogEnt{}
+	now := time.Now().UnixNano()
+	for i := range 1000 {
+		logs = append(logs, &LogEnt{
+			Time: now + int64(i),
+			Src:  "192.168.1.1",
+			Log:  fmt.Sprintf(`{"seq":%d,"content":"%s"}`, i, strings.Repeat("x", 2048)),
+		})
+	}
+	SaveLogs("syslog", logs)
+	ClearLog("syslog")
+	r, err := RunDBMaintenance(true)
+	if err != nil {
+		t.Fatalf("db maintenance err=%v", err)
+	}
+	if !r.Flatten || r.Time == 0 || r.Duration < 1 {
+		t.Errorf("invalid result %+v", r)
+	}
+	s := GetDBStats()
+	if s.LastGC == nil || s.LastGC.Time != r.Time {
+		t.Errorf("last gc must be set %+v", s.LastGC)
+	}
+	if s.LSMSize+s.VlogSize != GetDBSize() {
+		t.Errorf("invalid size %+v", s)
+	}
+}
+
+func TestDBMaintenanceJob(t *testing.T) {
+	defer func() {
+		Config.DBGCInterval = 0
+		Config.DBCompactInterval = 0
+	}()
+	now := time.Now()
+	for _, tc := range []struct {
+		gc      int
+		compact int
+		last    time.Duration
+		want    int
+	}{
+		{10, 0, time.Minute * 5, dbMaintenanceNone},
+		{10, 0, time.Minute * 10, dbMaintenanceGC},
+		{0, 0, time.Hour * 48, dbMaintenanceNone},
+		{0, 24, time.Hour * 23, dbMaintenanceNone},
+		{0, 24, time.Hour * 24, dbMaintenanceCompact},
+		{10, 24, time.Hour, dbMaintenanceGC},
+		{10, 24, time.Hour * 24, dbMaintenanceCompact},
+	} {
+		Config.DBGCInterval = tc.gc
+		Config.DBCompactInterval = tc.compact
+		if got := checkDBMaintenanceJob(now, now.Add(-tc.last), now.Add(-tc.last)); got != tc.want {
+			t.Errorf("gc=%d compact=%d last=%v got %d want %d", tc.gc, tc.compact, tc.last, got, tc.want)
+		}
+	}
+	Config.DBGCInterval = 0
+	Config.DBCompactInterval = 0
+	RequestDBCompaction()
+	defer dbCompactRequested.Store(false)
+	if got := checkDBMaintenanceJob(now, now, now); got != dbMaintenanceCompact {
+		t.Errorf("requested compaction got %d", got)
+	}
+}
+
+func TestDBQuietHours(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2025, 10, 25, h, m, 0, 0, time.Local)
+	}
+	for _, tc := range []struct {
+		s    string
+		t    time.Time
+		want bool
+	}{
+		{"", at(12, 0), true},
+		{"01:00-05:00", at(1, 0), true},
+		{"01:00-05:00", at(4, 59), true},
+		{"01:00-05:00", at(5, 0), false},
+		{"23:00-05:00", at(23, 30), true},
+		{"23:00-05:00", at(3, 0), true},
+		{"23:00-05:00", at(12, 0), false},
+	} {
+		if got := inDBQuietHours(tc.s, tc.t); got != tc.want {
+			t.Errorf("quiet hours %q %v got %v want %v", tc.s, tc.t, got, tc.want)
+		}
+	}
+	for _, s := range []string{"01:00", "1-5", "01:00-25:00"} {
+		if err := CheckDBQuietHours(s); err == nil {
+			t.Errorf("quiet hours %q must be error", s)
+		}
+	}
+}
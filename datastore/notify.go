@@ -12,6 +12,9 @@ import (
 
 func ClearNotify() {
 	db.DropPrefix([]byte("notify:"))
+	db.DropPrefix([]byte("incident:"))
+	db.DropPrefix([]byte("workflow:"))
+	db.DropPrefix([]byte("escalation:"))
 }
 
 type NotifyEnt struct {
@@ -25,11 +28,53 @@ type NotifyEnt struct {
 	Title string
 	Tags  string
 	Level string
+	// Incident
+	IncidentID string `json:",omitempty"`
+	Count      int    `json:",omitempty"`
+	FirstSeen  int64  `json:",omitempty"`
+	LastSeen   int64  `json:",omitempty"`
+	// Escalation step (0 is first notify)
+	Escalation int `json:",omitempty"`
+	// Delivery status of each destination
+	Delivery []NotifyDeliveryEnt `json:",omitempty"`
+	// Results of response actions
+	Actions []NotifyActionEnt `json:",omitempty"`
+}
+
+// NotifyDeliveryEnt is delivery status of notify to one destination.
+type NotifyDeliveryEnt struct {
+	Dst string
+	// Status is sent,retry,digest or dead
+	Status   string
+	Attempts int
+	Time     int64
+	Error    string
+	// Escalation step of delivery (0=first notification)
+	Escalation int
+}
+
+// NotifyActionEnt is result of response action.
+type NotifyActionEnt struct {
+	Name string
+	Type string
+	// Status is ok,error or dry-run
+	Status string
+	// Exit code of exec or HTTP status code
+	Code   int
+	Output string
+	Error  string
+	Time   int64
+	// Duration in milliseconds
+	Duration int64
+}
+
+func getNotifyKey(t int64, id string) string {
+	return fmt.Sprintf("notify:%016x:%s", t, id)
 }
 
 func SaveNotify(n *NotifyEnt) {
 	db.Update(func(txn *badger.Txn) error {
-		k := fmt.Sprintf("notify:%016x:%s", n.Time, n.ID)
+		k := getNotifyKey(n.Time, n.ID)
 		if v, err := json.Marshal(n); err == nil {
 			e := badger.NewEntry([]byte(k), []byte(v)).WithTTL(time.Hour * 24 * time.Duration(Config.NotifyRetention))
 			if err := txn.SetEntry(e); err != nil {
@@ -72,3 +117,53 @@ func ForEachNotify(st, et int64, callBack func(n *NotifyEnt) bool) {
 		return nil
 	})
 }
+
+// UpdateNotifyDelivery updates delivery status of notify.
+func UpdateNotifyDelivery(t int64, id string, d *NotifyDeliveryEnt) {
+	updateNotify(t, id, func(n *NotifyEnt) {
+		for i := range n.Delivery {
+			if n.Delivery[i].Dst == d.Dst && n.Delivery[i].Escalation == d.Escalation {
+				n.Delivery[i] = *d
+				return
+			}
+		}
+		n.Delivery = append(n.Delivery, *d)
+	})
+}
+
+// AddNotifyAction adds result of response action to notify.
+func AddNotifyAction(t int64, id string, a *NotifyActionEnt) {
+	updateNotify(t, id, func(n *NotifyEnt) {
+		n.Actions = append(n.Actions, *a)
+	})
+}
+
+// updateNotify updates saved notify. Retry on conflict with other updates.
+func updateNotify(t int64, id string, update func(n *NotifyEnt)) {
+	k := []byte(getNotifyKey(t, id))
+	for i := 0; i < 3; i++ {
+		err := db.Update(func(txn *badger.Txn) error {
+			item, err := txn.Get(k)
+			if err != nil {
+				return err
+			}
+			var n NotifyEnt
+			if err := item.Value(func(v []byte) error {
+				return json.Unmarshal(v, &n)
+			}); err != nil {
+				return err
+			}
+			update(&n)
+			v, err := json.Marshal(&n)
+			if err != nil {
+				return err
+			}
+			e := badger.NewEntry(k, v)
+			e.ExpiresAt = item.ExpiresAt()
+			return txn.SetEntry(e)
+		})
+		if err != badger.ErrConflict {
+			return
+		}
+	}
+}
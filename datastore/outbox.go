@@ -0,0 +1,131 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// OutboxEnt is pending delivery of notify to one destination.
+type OutboxEnt struct {
+	Key       string
+	Dst       string
+	Notify    NotifyEnt
+	Created   int64
+	Attempts  int
+	LastTry   int64
+	NextTry   int64
+	LastError string
+}
+
+func NewOutbox(n *NotifyEnt, dst string, now int64) *OutboxEnt {
+	nc := *n
+	nc.Delivery = nil
+	return &OutboxEnt{
+		Key:     fmt.Sprintf("%016x:%s:%s", now, dst, n.ID),
+		Dst:     dst,
+		Notify:  nc,
+		Created: now,
+	}
+}
+
+func SaveOutbox(o *OutboxEnt) {
+	saveOutbox("outbox:", o)
+}
+
+func DeleteOutbox(key string) {
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte("outbox:" + key))
+	})
+}
+
+func ForEachOutbox(callBack func(o *OutboxEnt) bool) {
+	forEachOutbox("outbox:", callBack)
+}
+
+// MoveToDeadLetter moves delivery from outbox to dead letter list.
+// Dead letters expire with notify retention.
+func MoveToDeadLetter(o *OutboxEnt) {
+	db.Update(func(txn *badger.Txn) error {
+		v, err := json.Marshal(o)
+		if err != nil {
+			return err
+		}
+		e := badger.NewEntry([]byte("deadletter:"+o.Key), v).WithTTL(time.Hour * 24 * time.Duration(Config.NotifyRetention))
+		if err := txn.SetEntry(e); err != nil {
+			return err
+		}
+		return txn.Delete([]byte("outbox:" + o.Key))
+	})
+}
+
+func ForEachDeadLetter(callBack func(o *OutboxEnt) bool) {
+	forEachOutbox("deadletter:", callBack)
+}
+
+// RedriveDeadLetter moves dead letters back to outbox. Empty key is all.
+func RedriveDeadLetter(key string) int {
+	list := []*OutboxEnt{}
+	ForEachDeadLetter(func(o *OutboxEnt) bool {
+		if key == "" || key == o.Key {
+			list = append(list, o)
+		}
+		return true
+	})
+	for _, o := range list {
+		o.Attempts = 0
+		o.NextTry = 0
+		db.Update(func(txn *badger.Txn) error {
+			v, err := json.Marshal(o)
+			if err != nil {
+				return err
+			}
+			if err := txn.Set([]byte("outbox:"+o.Key), v); err != nil {
+				return err
+			}
+			return txn.Delete([]byte("deadletter:" + o.Key))
+		})
+	}
+	return len(list)
+}
+
+// DeleteDeadLetter deletes dead letters. Empty key is all.
+func DeleteDeadLetter(key string) {
+	if key == "" {
+		db.DropPrefix([]byte("deadletter:"))
+		return
+	}
+	db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte("deadletter:" + key))
+	})
+}
+
+func saveOutbox(prefix string, o *OutboxEnt) {
+	db.Update(func(txn *badger.Txn) error {
+		v, err := json.Marshal(o)
+		if err != nil {
+			return err
+		}
+		return txn.Set([]byte(prefix+o.Key), v)
+	})
+}
+
+func forEachOutbox(prefix string, callBack func(o *OutboxEnt) bool) {
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek([]byte(prefix)); it.ValidForPrefix([]byte(prefix)); it.Next() {
+			var o OutboxEnt
+			if err := it.Item().Value(func(v []byte) error {
+				return json.Unmarshal(v, &o)
+			}); err == nil {
+				if !callBack(&o) {
+					break
+				}
+			}
+		}
+		return nil
+	})
+}
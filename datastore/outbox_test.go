@@ -0,0 +1,38 @@
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestDeadLetter(t *testing.T) {
+	Config.DBPath = ""
+	Config.NotifyRetention = 7
+	OpenDB()
+	defer CloseDB()
+	o := NewOutbox(&NotifyEnt{Time: time.Now().UnixNano(), ID: "rule1"}, "webhook:1", time.Now().UnixNano())
+	SaveOutbox(o)
+	MoveToDeadLetter(o)
+	n := 0
+	ForEachOutbox(func(o *OutboxEnt) bool {
+		n++
+		return true
+	})
+	if n != 0 {
+		t.Errorf("outbox not deleted %d", n)
+	}
+	// Dead letter expires with notify retention
+	db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte("deadletter:" + o.Key))
+		if err != nil {
+			t.Fatalf("dead letter not found err=%v", err)
+		}
+		d := time.Until(time.Unix(int64(item.ExpiresAt()), 0))
+		if want := time.Hour * 24 * 7; d > want || d < want-time.Minute {
+			t.Errorf("invalid dead letter ttl %v", d)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,522 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Log query is a list of terms combined with AND, OR, NOT and ( ).
+// Terms without operator are ANDed.
+//  error                  text in log (case-insensitive, * and ? are wildcards)
+//  "disk full"            phrase in log (case-insensitive)
+//  /fail(ed|ure)/         regular expression on log
+//  hostname:fw*           field equals value (case-insensitive, * and ? are wildcards)
+//  tag:"cron job"         field equals quoted value
+//  content:/time.?out/    field matches regular expression
+//  severity:[0 TO 3]      field is in range. [ ] is inclusive, { } is exclusive and * is open.
+//  severity<=3            field compares with value (< <= > >= = !=)
+//  msg:*                  field exists
+//  src:192.168.1.0/24     source of log is in CIDR (src:fw* and src=10.0.0.1 are also OK)
+// Field is key of JSON log. Nested field is joined by dot like Event.System.EventID.
+
+// LogQuery : parsed log query
+type LogQuery struct {
+	root queryNode
+}
+
+type queryNode interface {
+	match(d *queryData) bool
+}
+
+type queryData struct {
+	l      *LogEnt
+	m      map[string]any
+	parsed bool
+}
+
+type queryAnd []queryNode
+
+type queryOr []queryNode
+
+type queryNot struct {
+	n queryNode
+}
+
+type queryTerm struct {
+	Field string
+	Op    string
+	Value string
+	re    *regexp.Regexp
+	ipnet *net.IPNet
+	// range
+	lo, hi       string
+	loInc, hiInc bool
+	exists       bool
+	// text or phrase without wildcard
+	word bool
+	// value without wildcard
+	plain bool
+}
+
+// ParseLogQuery : parse log query. Empty query matches all logs.
+func ParseLogQuery(q string) (*LogQuery, error) {
+	tokens, err := tokenizeLogQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) < 1 {
+		return &LogQuery{}, nil
+	}
+	p := &queryParser{tokens: tokens}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %s", p.tokens[p.pos])
+	}
+	return &LogQuery{root: n}, nil
+}
+
+// Match : check log matches query
+func (q *LogQuery) Match(l *LogEnt) bool {
+	if q == nil || q.root == nil {
+		return true
+	}
+	return q.root.match(&queryData{l: l})
+}
+
+func tokenizeLogQuery(q string) ([]string, error) {
+	ret := []string{}
+	for i := 0; i < len(q); {
+		c := q[i]
+		if isQuerySpace(c) {
+			i++
+			continue
+		}
+		if c == '(' || c == ')' {
+			ret = append(ret, string(c))
+			i++
+			continue
+		}
+		st := i
+		valueStart := true
+		for i < len(q) {
+			c = q[i]
+			if isQuerySpace(c) || c == '(' || c == ')' {
+				break
+			}
+			if valueStart && (c == '"' || c == '/' || c == '[' || c == '{') {
+				end := byte(c)
+				switch c {
+				case '[':
+					end = ']'
+				case '{':
+					end = '}'
+				}
+				j := i + 1
+				for ; j < len(q) && q[j] != end; j++ {
+					if q[j] == '\\' && end != ']' && end != '}' {
+						j++
+					}
+				}
+				if j >= len(q) {
+					return nil, fmt.Errorf("unterminated %c", c)
+				}
+				i = j + 1
+				valueStart = false
+				continue
+			}
+			valueStart = strings.IndexByte(":=<>!", c) >= 0
+			i++
+		}
+		ret = append(ret, q[st:i])
+	}
+	return ret, nil
+}
+
+func isQuerySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *queryParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	n, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	or := queryOr{n}
+	for p.peek() == "OR" {
+		p.pos++
+		n, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, n)
+	}
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	n, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	and := queryAnd{n}
+	for p.pos < len(p.tokens) && p.peek() != "OR" && p.peek() != ")" {
+		if p.peek() == "AND" {
+			p.pos++
+		}
+		n, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, n)
+	}
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+func (p *queryParser) parseNot() (queryNode, error) {
+	if p.peek() == "NOT" {
+		p.pos++
+		n, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNot{n: n}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	t := p.peek()
+	switch t {
+	case "":
+		return nil, fmt.Errorf("unexpected end of query")
+	case ")", "AND", "OR":
+		return nil, fmt.Errorf("unexpected %s", t)
+	case "(":
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		p.pos++
+		return n, nil
+	}
+	p.pos++
+	return parseQueryTerm(t)
+}
+
+var queryOps = []string{"!=", "<=", ">=", ":", "=", "<", ">"}
+
+func parseQueryTerm(s string) (*queryTerm, error) {
+	t := &queryTerm{}
+	i := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		if !(c == '_' || c == '.' || c == '@' || c == '-' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')) {
+			break
+		}
+	}
+	if i > 0 {
+		for _, op := range queryOps {
+			if strings.HasPrefix(s[i:], op) {
+				t.Field = s[:i]
+				t.Op = op
+				t.Value = s[i+len(op):]
+				break
+			}
+		}
+	}
+	if t.Op == "" {
+		return t, t.compileText(s)
+	}
+	if t.Value == "" {
+		return nil, fmt.Errorf("no value of %s", t.Field)
+	}
+	if t.Op != ":" {
+		t.Value = unquoteQueryValue(t.Value)
+		return t, nil
+	}
+	v := t.Value
+	switch {
+	case v == "*":
+		t.exists = true
+	case len(v) > 1 && v[0] == '/' && v[len(v)-1] == '/':
+		re, err := regexp.Compile(v[1 : len(v)-1])
+		if err != nil {
+			return nil, err
+		}
+		t.re = re
+	case len(v) > 1 && (v[0] == '[' || v[0] == '{'):
+		a := strings.Split(v[1:len(v)-1], " TO ")
+		if len(a) != 2 {
+			return nil, fmt.Errorf("invalid range %s", v)
+		}
+		t.lo = strings.TrimSpace(a[0])
+		t.hi = strings.TrimSpace(a[1])
+		t.loInc = v[0] == '['
+		t.hiInc = v[len(v)-1] == ']'
+		if t.lo == "" || t.hi == "" {
+			return nil, fmt.Errorf("invalid range %s", v)
+		}
+	case v[0] == '"':
+		t.Value = unquoteQueryValue(v)
+		t.re = regexp.MustCompile("(?i)^" + regexp.QuoteMeta(t.Value) + "$")
+		t.plain = true
+	default:
+		if t.Field == "src" {
+			if _, n, err := net.ParseCIDR(v); err == nil {
+				t.ipnet = n
+				break
+			}
+		}
+		t.re = regexp.MustCompile("(?i)^" + wildcardToRegexp(v) + "$")
+		t.plain = !strings.ContainsAny(v, "*?")
+	}
+	return t, nil
+}
+
+// compileText makes term without field. Text and phrase match a part of log.
+func (t *queryTerm) compileText(s string) error {
+	if len(s) > 1 && s[0] == '/' && s[len(s)-1] == '/' {
+		re, err := regexp.Compile(s[1 : len(s)-1])
+		if err != nil {
+			return err
+		}
+		t.re = re
+		return nil
+	}
+	t.Value = s
+	p := ""
+	if s[0] == '"' {
+		t.Value = unquoteQueryValue(s)
+		p = regexp.QuoteMeta(t.Value)
+		t.word = true
+	} else {
+		p = wildcardToRegexp(s)
+		t.word = !strings.ContainsAny(s, "*?")
+	}
+	if t.Value == "" {
+		return fmt.Errorf("empty phrase")
+	}
+	t.re = regexp.MustCompile("(?i)" + p)
+	return nil
+}
+
+func unquoteQueryValue(v string) string {
+	if len(v) > 1 && v[0] == '"' {
+		if s, err := strconv.Unquote(v); err == nil {
+			return s
+		}
+		return strings.Trim(v, "\"")
+	}
+	return v
+}
+
+func wildcardToRegexp(s string) string {
+	s = regexp.QuoteMeta(s)
+	s = strings.ReplaceAll(s, `\*`, ".*")
+	return strings.ReplaceAll(s, `\?`, ".")
+}
+
+func (n queryAnd) match(d *queryData) bool {
+	for _, c := range n {
+		if !c.match(d) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n queryOr) match(d *queryData) bool {
+	for _, c := range n {
+		if c.match(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *queryNot) match(d *queryData) bool {
+	return !n.n.match(d)
+}
+
+func (t *queryTerm) match(d *queryData) bool {
+	if t.Op == "" {
+		return t.re.MatchString(d.l.Log)
+	}
+	vals := d.values(t.Field)
+	if t.Op == "!=" {
+		for _, v := range vals {
+			if c, _ := compareQueryValue(v, t.Value); c == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range vals {
+		if t.matchValue(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *queryTerm) matchValue(v any) bool {
+	switch t.Op {
+	case "=":
+		c, _ := compareQueryValue(v, t.Value)
+		return c == 0
+	case "<":
+		c, ok := compareQueryValue(v, t.Value)
+		return ok && c < 0
+	case "<=":
+		c, ok := compareQueryValue(v, t.Value)
+		return ok && c <= 0
+	case ">":
+		c, ok := compareQueryValue(v, t.Value)
+		return ok && c > 0
+	case ">=":
+		c, ok := compareQueryValue(v, t.Value)
+		return ok && c >= 0
+	}
+	switch {
+	case t.exists:
+		return true
+	case t.ipnet != nil:
+		ip := net.ParseIP(queryValueString(v))
+		return ip != nil && t.ipnet.Contains(ip)
+	case t.re != nil:
+		return t.re.MatchString(queryValueString(v))
+	}
+	if t.lo != "*" {
+		c, ok := compareQueryValue(v, t.lo)
+		if !ok || c < 0 || (c == 0 && !t.loInc) {
+			return false
+		}
+	}
+	if t.hi != "*" {
+		c, ok := compareQueryValue(v, t.hi)
+		if !ok || c > 0 || (c == 0 && !t.hiInc) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareQueryValue compares value with string. Numbers are compared as numbers.
+// ok is false if number is compared with string.
+func compareQueryValue(v any, s string) (int, bool) {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		var n float64
+		switch x := v.(type) {
+		case float64:
+			n = x
+		case string:
+			if n, err = strconv.ParseFloat(x, 64); err != nil {
+				return strings.Compare(x, s), false
+			}
+		default:
+			return strings.Compare(queryValueString(v), s), false
+		}
+		switch {
+		case n < f:
+			return -1, true
+		case n > f:
+			return 1, true
+		}
+		return 0, true
+	}
+	if _, ok := v.(float64); ok {
+		return strings.Compare(queryValueString(v), s), false
+	}
+	return strings.Compare(queryValueString(v), s), true
+}
+
+func queryValueString(v any) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	case nil:
+		return ""
+	}
+	if j, err := json.Marshal(v); err == nil {
+		return string(j)
+	}
+	return ""
+}
+
+// values returns values of field. Array is expanded.
+func (d *queryData) values(field string) []any {
+	if field == "src" {
+		return []any{d.l.Src}
+	}
+	if !d.parsed {
+		d.parsed = true
+		_ = json.Unmarshal([]byte(d.l.Log), &d.m)
+	}
+	v, ok := getQueryField(d.m, field)
+	if !ok {
+		return nil
+	}
+	if a, ok := v.([]any); ok {
+		return a
+	}
+	return []any{v}
+}
+
+func getQueryField(m map[string]any, field string) (any, bool) {
+	if m == nil {
+		return nil, false
+	}
+	if v, ok := m[field]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, field) {
+			return v, true
+		}
+	}
+	a := strings.SplitN(field, ".", 2)
+	if len(a) != 2 {
+		return nil, false
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, a[0]) {
+			if c, ok := v.(map[string]any); ok {
+				return getQueryField(c, a[1])
+			}
+		}
+	}
+	return nil, false
+}
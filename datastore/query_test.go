@@ -0,0 +1,79 @@
+package datastore
+
+import "testing"
+
+func TestLogQuery(t *testing.T) {
+	syslog := &LogEnt{
+		Src: "192.168.1.10",
+		Log: `{"hostname":"fw01","severity":3,"facility":4,"tag":"sshd","content":"Failed password for root","pid":"123"}`,
+	}
+	cron := &LogEnt{
+		Src: "10.0.0.1",
+		Log: `{"hostname":"web01","severity":6,"tag":"cron","content":"(root) CMD (run-parts /etc/cron.hourly)"}`,
+	}
+	winevent := &LogEnt{
+		Src: "win01",
+		Log: `{"Event":{"System":{"EventID":4625,"Provider":{"Name":"Microsoft-Windows-Security-Auditing"}},"EventData":{"IpAddress":"192.168.1.99"}},"tags":["a","b"]}`,
+	}
+	tests := []struct {
+		q    string
+		l    *LogEnt
+		want bool
+	}{
+		{"", syslog, true},
+		{"failed", syslog, true},
+		{"fail", syslog, true},
+		{"fail*", syslog, true},
+		{"word", syslog, true},
+		{"words", syslog, false},
+		{"fail*root", syslog, true},
+		{`"password for root"`, syslog, true},
+		{`"password root"`, syslog, false},
+		{"/Fail(ed|ure)/", syslog, true},
+		{"/fail(ed|ure)/", syslog, false},
+		{"severity<=3 AND hostname:fw* AND NOT tag:cron", syslog, true},
+		{"severity<=3 AND hostname:fw* AND NOT tag:cron", cron, false},
+		{"severity<=3 hostname:FW*", syslog, true},
+		{"severity:[0 TO 3]", syslog, true},
+		{"severity:{0 TO 3}", syslog, false},
+		{"severity:[4 TO *]", cron, true},
+		{"severity>3", syslog, false},
+		{"severity=3", syslog, true},
+		{"severity!=3", syslog, false},
+		{"severity!=3", cron, true},
+		{"pid>100", syslog, true},
+		{"tag:ssh", syslog, false},
+		{"tag:sshd OR tag:cron", cron, true},
+		{"(tag:sshd OR tag:cron) AND severity<5", cron, false},
+		{`content:"failed password for root"`, syslog, true},
+		{"content:/^\\(root\\)/", cron, true},
+		{"msg:*", syslog, false},
+		{"content:*", syslog, true},
+		{"src:192.168.1.0/24", syslog, true},
+		{"src:192.168.1.0/24", cron, false},
+		{"src:win*", winevent, true},
+		{"src=10.0.0.1", cron, true},
+		{"Event.System.EventID=4625", winevent, true},
+		{"event.system.provider.name:*security*", winevent, true},
+		{"Event.EventData.IpAddress:/^192\\.168\\./", winevent, true},
+		{"tags:b", winevent, true},
+		{"tags:c", winevent, false},
+		{"NOT NOT failed", syslog, true},
+		{"hostname:fw* tag:cron OR tag:sshd", syslog, true},
+	}
+	for _, tc := range tests {
+		q, err := ParseLogQuery(tc.q)
+		if err != nil {
+			t.Errorf("parse %q err=%v", tc.q, err)
+			continue
+		}
+		if got := q.Match(tc.l); got != tc.want {
+			t.Errorf("query %q log %s got %v want %v", tc.q, tc.l.Log, got, tc.want)
+		}
+	}
+	for _, q := range []string{"(tag:cron", "tag:cron)", "AND tag:cron", "tag:", "content:/(/", `content:"abc`, "severity:[1 3]", "NOT"} {
+		if _, err := ParseLogQuery(q); err == nil {
+			t.Errorf("parse %q must be error", q)
+		}
+	}
+}
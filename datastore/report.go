@@ -244,7 +244,9 @@ func ForEachTrapReport(st, et int64, callBack func(r *TrapReportEnt) bool) {
 
 type NetflowLogEnt struct {
 	Time int64
-	Log  map[string]any
+	// Exporter IP
+	Src string
+	Log map[string]any
 }
 
 type NetflowPacketsSummaryEnt struct {
@@ -262,6 +264,54 @@ type NetflowKeyCountEnt struct {
 	Count int
 }
 
+type NetflowScanEnt struct {
+	Time      int64
+	Type      string
+	Src       string
+	Dst       string
+	PortRange string
+	Count     int
+	Rate      float64
+	// Targets of horizontal scan
+	Targets []string `json:",omitempty"`
+}
+
+type NetflowBeaconEnt struct {
+	Time     int64
+	Src      string
+	Dst      string
+	Port     int
+	Count    int
+	Interval float64
+	Jitter   float64
+	Score    float64
+}
+
+type NetflowZoneEnt struct {
+	Src     string
+	Dst     string
+	Packets int64
+	Bytes   int64
+	Flows   int
+}
+
+type NetflowIfEnt struct {
+	Exporter      string
+	IfIndex       int
+	Name          string
+	InBytes       int64
+	OutBytes      int64
+	InPackets     int64
+	OutPackets    int64
+	InBps         float64
+	OutBps        float64
+	InPps         float64
+	OutPps        float64
+	InUtil        float64
+	OutUtil       float64
+	TopTalkerList []NetflowBytesSummaryEnt
+}
+
 type NetflowReportEnt struct {
 	Time               int64
 	Packets            int64
@@ -285,6 +335,12 @@ type NetflowReportEnt struct {
 	TopHostList        []NetflowKeyCountEnt
 	TopLocList         []NetflowKeyCountEnt
 	TopCountryList     []NetflowKeyCountEnt
+	Scans              int
+	ScanList           []NetflowScanEnt
+	Beacons            int
+	BeaconList         []NetflowBeaconEnt
+	ZoneMatrix         []NetflowZoneEnt
+	IfList             []NetflowIfEnt
 }
 
 func SaveNetflowReport(r *NetflowReportEnt) {
@@ -535,15 +591,19 @@ func ForEachAnomalyReport(t string, st, et int64, callBack func(r *AnomalyReport
 }
 
 type MonitorReportEnt struct {
-	Time    int64
-	CPU     float64
-	Memory  float64
-	Load    float64
-	Disk    float64
-	Net     float64
-	Bytes   int64
-	DBSpeed float64
-	DBSize  int64
+	Time     int64
+	CPU      float64
+	Memory   float64
+	Load     float64
+	Disk     float64
+	Net      float64
+	Bytes    int64
+	DBSpeed  float64
+	DBSize   int64
+	Usage    []*DBUsageEnt `json:",omitempty"`
+	LSMSize  int64
+	VlogSize int64
+	LastGC   *DBMaintenanceEnt `json:",omitempty"`
 }
 
 func SaveMonitorReport(r *MonitorReportEnt) {
@@ -0,0 +1,342 @@
+package datastore
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Log retention rule is "<type>[/<src>]=<hours>" like
+// netflow=48 syslog=2160 windows=8760 syslog/192.168.1.0/24=24 syslog/fw*=720
+// src is IP, CIDR or host name pattern. Rules with src are used before rules without src.
+
+type logRetentionRuleEnt struct {
+	Type  string
+	Src   *regexp.Regexp
+	IPNet *net.IPNet
+	TTL   time.Duration
+}
+
+var logRetentionMu sync.Mutex
+var logRetentionRules []*logRetentionRuleEnt
+var logRetentionRulesSrc string
+
+// Default priority of log types (low to high) for DB quota
+var defaultLogPriority = []string{"netflow", "otel", "mqtt", "trap", "syslog", "windows"}
+
+func parseLogRetentionRule(s string) (*logRetentionRuleEnt, error) {
+	i := strings.LastIndex(s, "=")
+	if i < 1 {
+		return nil, fmt.Errorf("invalid rule %s", s)
+	}
+	h, err := strconv.Atoi(strings.TrimSpace(s[i+1:]))
+	if err != nil || h < 1 {
+		return nil, fmt.Errorf("invalid hours %s", s)
+	}
+	r := &logRetentionRuleEnt{TTL: time.Hour * time.Duration(h)}
+	a := strings.SplitN(strings.TrimSpace(s[:i]), "/", 2)
+	r.Type = a[0]
+	if !slices.Contains(logTypes, r.Type) {
+		return nil, fmt.Errorf("invalid log type %s", r.Type)
+	}
+	if len(a) == 2 && a[1] != "" {
+		if _, n, err := net.ParseCIDR(a[1]); err == nil {
+			r.IPNet = n
+		} else {
+			r.Src = regexp.MustCompile("(?i)^" + wildcardToRegexp(a[1]) + "$")
+		}
+	}
+	return r, nil
+}
+
+func getLogRetentionRules() []*logRetentionRuleEnt {
+	logRetentionMu.Lock()
+	defer logRetentionMu.Unlock()
+	src := strings.Join(Config.LogRetentionRule, ",")
+	if logRetentionRules != nil && src == logRetentionRulesSrc {
+		return logRetentionRules
+	}
+	logRetentionRulesSrc = src
+	logRetentionRules = []*logRetentionRuleEnt{}
+	for _, s := range Config.LogRetentionRule {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		r, err := parseLogRetentionRule(s)
+		if err != nil {
+			log.Printf("invalid log retention rule err=%v", err)
+			continue
+		}
+		logRetentionRules = append(logRetentionRules, r)
+	}
+	// Rules with src first
+	sort.SliceStable(logRetentionRules, func(i, j int) bool {
+		a := logRetentionRules[i].Src != nil || logRetentionRules[i].IPNet != nil
+		b := logRetentionRules[j].Src != nil || logRetentionRules[j].IPNet != nil
+		return a && !b
+	})
+	return logRetentionRules
+}
+
+// CheckLogRetentionRule : check log retention rules
+func CheckLogRetentionRule(list []string) error {
+	for _, s := range list {
+		if _, err := parseLogRetentionRule(strings.TrimSpace(s)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getLogRetention returns TTL of log by type and source.
+func getLogRetention(t, src string) time.Duration {
+	var ip net.IP
+	for _, r := range getLogRetentionRules() {
+		if r.Type != t {
+			continue
+		}
+		switch {
+		case r.IPNet != nil:
+			if ip == nil {
+				ip = net.ParseIP(src)
+			}
+			if ip == nil || !r.IPNet.Contains(ip) {
+				continue
+			}
+		case r.Src != nil:
+			if !r.Src.MatchString(src) {
+				continue
+			}
+		}
+		return r.TTL
+	}
+	return time.Hour * time.Duration(Config.LogRetention)
+}
+
+// getMaxLogRetention returns max TTL of log type.
+func getMaxLogRetention(t string) time.Duration {
+	ret := time.Hour * time.Duration(Config.LogRetention)
+	for _, r := range getLogRetentionRules() {
+		if r.Type == t {
+			ret = max(ret, r.TTL)
+		}
+	}
+	return ret
+}
+
+// DBUsageEnt : usage of DB per key prefix (log type, report, notify...)
+type DBUsageEnt struct {
+	Type   string
+	Keys   int64
+	Size   int64
+	Oldest int64 `json:",omitempty"`
+	Newest int64 `json:",omitempty"`
+}
+
+// GetDBUsage : get estimated size and number of keys per key prefix
+func GetDBUsage() []*DBUsageEnt {
+	m := make(map[string]*DBUsageEnt)
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			k := item.Key()
+			a := strings.SplitN(string(k), ":", 3)
+			u, ok := m[a[0]]
+			if !ok {
+				u = &DBUsageEnt{Type: a[0]}
+				m[a[0]] = u
+			}
+			u.Keys++
+			u.Size += item.EstimatedSize()
+			if len(a) == 3 && slices.Contains(logTypes, a[0]) {
+				if ts, err := strconv.ParseInt(a[1], 16, 64); err == nil {
+					if u.Oldest == 0 || ts < u.Oldest {
+						u.Oldest = ts
+					}
+					u.Newest = max(u.Newest, ts)
+				}
+			}
+		}
+		return nil
+	})
+	ret := []*DBUsageEnt{}
+	for _, u := range m {
+		ret = append(ret, u)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Size > ret[j].Size
+	})
+	dbUsageMu.Lock()
+	dbUsageCache = ret
+	dbUsageTime = time.Now()
+	dbUsageMu.Unlock()
+	return ret
+}
+
+var dbUsageMu sync.Mutex
+var dbUsageCache []*DBUsageEnt
+var dbUsageTime time.Time
+
+// GetCachedDBUsage : get DB usage scanned within age. DB is scanned again if cache is old.
+func GetCachedDBUsage(age time.Duration) []*DBUsageEnt {
+	dbUsageMu.Lock()
+	if dbUsageCache != nil && time.Since(dbUsageTime) < age {
+		ret := dbUsageCache
+		dbUsageMu.Unlock()
+		return ret
+	}
+	dbUsageMu.Unlock()
+	return GetDBUsage()
+}
+
+func clearDBUsageCache() {
+	dbUsageMu.Lock()
+	defer dbUsageMu.Unlock()
+	dbUsageCache = nil
+}
+
+func getLogPriority() []string {
+	ret := []string{}
+	for _, t := range Config.LogPriority {
+		if t = strings.TrimSpace(t); slices.Contains(logTypes, t) && !slices.Contains(ret, t) {
+			ret = append(ret, t)
+		}
+	}
+	// Types not in config are lowest
+	for i := len(defaultLogPriority) - 1; i >= 0; i-- {
+		if !slices.Contains(ret, defaultLogPriority[i]) {
+			ret = append([]string{defaultLogPriority[i]}, ret...)
+		}
+	}
+	return ret
+}
+
+// CheckDBQuota : evict oldest logs of lowest priority types if DB size on disk (LSM + value log) is over quota.
+// DB is scanned only if it is over quota. Compaction after eviction is run by DB maintenance.
+// It returns number of evicted logs.
+func CheckDBQuota() int64 {
+	if Config.DBQuota < 1 || db == nil {
+		return 0
+	}
+	quota := int64(Config.DBQuota) * 1024 * 1024
+	size := GetDBSize()
+	if Config.DBPath != "" && size <= quota {
+		return 0
+	}
+	usage := GetDBUsage()
+	total := int64(0)
+	for _, u := range usage {
+		total += u.Size
+	}
+	if Config.DBPath == "" {
+		// In memory DB has no size on disk
+		size = total
+	}
+	if size <= quota || total < 1 || size < 1 {
+		return 0
+	}
+	if Config.ArchiveDir != "" {
+		// Logs are archived before eviction
+		if err := ArchiveLogs(time.Now()); err != nil {
+			log.Printf("db quota archive err=%v", err)
+		}
+	}
+	// Evict to 90% of quota. Size of keys is estimated size, so scale it with disk size.
+	need := (size - quota*9/10) * total / size
+	evicted := int64(0)
+	for _, t := range getLogPriority() {
+		if need <= 0 {
+			break
+		}
+		n, s := evictOldestLogs(t, need)
+		if n > 0 {
+			log.Printf("db quota evict %s logs=%d size=%d", t, n, s)
+		}
+		need -= s
+		evicted += n
+	}
+	if evicted > 0 {
+		clearDBUsageCache()
+		if Config.DBPath != "" {
+			// Deleted logs use disk until compaction and value log GC
+			RequestDBCompaction()
+		}
+	}
+	return evicted
+}
+
+// evictOldestLogs deletes oldest logs of type until deleted size is over need.
+// Logs of archived type which are not archived yet are not evicted.
+func evictOldestLogs(t string, need int64) (int64, int64) {
+	limit := int64(math.MaxInt64)
+	if Config.ArchiveDir != "" && slices.Contains(getArchiveTypes(), t) {
+		limit = getArchivedEnd(t)
+	}
+	keys := [][]byte{}
+	size := int64(0)
+	db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		it := txn.NewIterator(opt)
+		defer it.Close()
+		prefix := []byte(t + ":")
+		for it.Seek(prefix); it.ValidForPrefix(prefix) && size < need; it.Next() {
+			item := it.Item()
+			if getLogKeyTime(item.Key()) >= limit {
+				break
+			}
+			keys = append(keys, item.KeyCopy(nil))
+			size += item.EstimatedSize()
+		}
+		return nil
+	})
+	if len(keys) < 1 {
+		return 0, 0
+	}
+	// Evicted batches are expired before their logs are deleted
+	seqs := make(map[int64]bool)
+	for _, k := range keys {
+		if seq := getLogKeySeq(k); seq > 0 {
+			seqs[seq] = true
+		}
+	}
+	if err := expireLogChain(t, seqs); err != nil {
+		log.Printf("db quota evict err=%v", err)
+		return 0, 0
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, k := range keys {
+		if err := wb.Delete(k); err != nil {
+			log.Printf("db quota evict err=%v", err)
+			return 0, 0
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		log.Printf("db quota evict err=%v", err)
+		return 0, 0
+	}
+	// Index buckets before the oldest log left have no logs
+	et := getFirstLogTime(t, 0, math.MaxInt64)
+	if et == 0 {
+		et = math.MaxInt64
+	}
+	if err := deleteLogIndexBefore(t, et); err != nil {
+		log.Printf("db quota evict index err=%v", err)
+	}
+	return int64(len(keys)), size
+}
@@ -0,0 +1,208 @@
+package datastore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestLogRetention(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.LogRetention = 24
+	Config.LogRetentionRule = []string{"netflow=2", "syslog=720", "syslog/192.168.1.0/24=48", "syslog/fw*=100"}
+	Config.LogPriority = nil
+	OpenDB()
+	defer func() {
+		CloseDB()
+		Config.LogRetentionRule = nil
+		Config.LogPriority = nil
+	}()
+	for _, tc := range []struct {
+		t   string
+		src string
+		h   int
+	}{
+		{"netflow", "10.0.0.1", 2},
+		{"syslog", "10.0.0.1", 720},
+		{"syslog", "192.168.1.5", 48},
+		{"syslog", "FW01", 100},
+		{"trap", "192.168.1.5", 24},
+	} {
+		if got := getLogRetention(tc.t, tc.src); got != time.Hour*time.Duration(tc.h) {
+			t.Errorf("retention %s %s got %v want %dh", tc.t, tc.src, got, tc.h)
+		}
+	}
+	if got := getMaxLogRetention("syslog"); got != time.Hour*720 {
+		t.Errorf("max retention got %v", got)
+	}
+	for _, r := range []string{"syslog", "syslog=0", "unknown=10", "syslog/fw=x"} {
+		if err := CheckLogRetentionRule([]string{r}); err == nil {
+			t.Errorf("rule %q must be error", r)
+		}
+	}
+
+	now := time.Now().UnixNano()
+	for _, lt := range []string{"syslog", "netflow"} {
+		logs := []*LogEnt{}
+		for i := range 10 {
+			logs = append(logs, &LogEnt{
+				Time: now - int64(10-i)*int64(time.Second),
+				Src:  fmt.Sprintf("192.168.%d.1", i%2),
+				Log:  fmt.Sprintf(`{"type":"%s","seq":%d}`, lt, i),
+			})
+		}
+		SaveLogs(lt, logs)
+	}
+	// TTL by source
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte("syslog:")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			src := ""
+			item.Value(func(v []byte) error {
+				src, _, _ = strings.Cut(string(v), "\t")
+				return nil
+			})
+			want := time.Hour * 720
+			if src == "192.168.1.1" {
+				want = time.Hour * 48
+			}
+			d := time.Until(time.Unix(int64(item.ExpiresAt()), 0))
+			if d > want || d < want-time.Minute {
+				t.Errorf("invalid ttl src=%s ttl=%v want %v", src, d, want)
+			}
+		}
+		return nil
+	})
+	usage := map[string]*DBUsageEnt{}
+	for _, u := range GetDBUsage() {
+		usage[u.Type] = u
+	}
+	if u, ok := usage["syslog"]; !ok || u.Keys != 10 || u.Size < 1 || u.Oldest != now-int64(10*time.Second) || u.Newest != now-int64(time.Second) {
+		t.Errorf("invalid syslog usage %+v", u)
+	}
+	if u, ok := usage["netflow"]; !ok || u.Keys != 10 {
+		t.Errorf("invalid netflow usage %+v", u)
+	}
+
+	// Priority
+	Config.LogPriority = []string{"syslog", "netflow"}
+	if p := getLogPriority(); len(p) != len(defaultLogPriority) || p[len(p)-2] != "syslog" || p[len(p)-1] != "netflow" {
+		t.Errorf("invalid priority %v", p)
+	}
+	// Evict oldest logs
+	n, size := evictOldestLogs("syslog", 1)
+	if n != 1 || size < 1 {
+		t.Errorf("evict n=%d size=%d", n, size)
+	}
+	c := 0
+	ForEachLog("syslog", 0, 0, func(l *LogEnt) bool {
+		if c == 0 && l.Time != now-int64(9*time.Second) {
+			t.Errorf("oldest log must be evicted %d", l.Time)
+		}
+		c++
+		return true
+	})
+	if c != 9 {
+		t.Errorf("invalid count after evict %d", c)
+	}
+}
+
+func TestCheckDBQuota(t *testing.T) {
+	Config.DBPath = filepath.Join(t.TempDir(), "db")
+	Config.LogRetention = 24
+	Config.LogPriority = []string{}
+	Config.LogIndex = []string{"src"}
+	Config.LogIndexBucket = 1
+	resetLogIndexInfo()
+	defer func() {
+		Config.DBPath = ""
+		Config.DBQuota = 0
+		Config.LogIndex = []string{}
+		Config.LogIndexBucket = 0
+		resetLogIndexInfo()
+		dbCompactRequested.Store(false)
+	}()
+	OpenDB()
+	now := time.Now().UnixNano()
+	for b := range 30 {
+		logs := []*LogEnt{}
+		for i := range 100 {
+			r := make([]byte, 500)
+			rand.Read(r)
+			logs = append(logs, &LogEnt{
+				Time: now - int64(time.Hour) + int64(b*100+i)*int64(time.Second),
+				Src:  "192.168.1.1",
+				Log:  hex.EncodeToString(r) + strings.Repeat(fmt.Sprintf(" log %d", i), 2000),
+			})
+		}
+		SaveLogs("syslog", logs)
+	}
+	CloseDB()
+	// DB size on disk is calculated when DB is opened
+	OpenDB()
+	defer CloseDB()
+	size := GetDBSize()
+	total := int64(0)
+	for _, u := range GetDBUsage() {
+		total += u.Size
+	}
+	if size < 1 || size*2 > total {
+		t.Fatalf("compressed disk size %d must be smaller than estimated size %d", size, total)
+	}
+	count := func() int {
+		c := 0
+		ForEachLog("syslog", 0, 0, func(l *LogEnt) bool {
+			c++
+			return true
+		})
+		return c
+	}
+	// Over quota by estimated size but under quota by disk size
+	Config.DBQuota = int((size + total) / 2 / 1024 / 1024)
+	if int64(Config.DBQuota)*1024*1024 <= size {
+		t.Fatalf("invalid quota %d size=%d total=%d", Config.DBQuota, size, total)
+	}
+	if n := CheckDBQuota(); n != 0 {
+		t.Errorf("logs must not be evicted under quota n=%d", n)
+	}
+	if c := count(); c != 3000 {
+		t.Errorf("logs must not be evicted under quota %d", c)
+	}
+	// Over quota by disk size
+	Config.DBQuota = int(size/1024/1024) - 1
+	if Config.DBQuota < 1 {
+		t.Fatalf("disk size is too small %d", size)
+	}
+	bucket := getLogIndexBucket()
+	first := now - int64(time.Hour)
+	if !getLogIndexBuckets("syslog", "s:192.168.1.1", bucket, 0, first)[first-first%bucket] {
+		t.Fatal("index of first log not found")
+	}
+	if n := CheckDBQuota(); n < 1 {
+		t.Errorf("logs must be evicted over quota n=%d", n)
+	}
+	if c := count(); c >= 3000 || c < 1 {
+		t.Errorf("invalid count after quota check %d", c)
+	}
+	// Index of evicted logs is deleted
+	oldest := getFirstLogTime("syslog", 0, now)
+	for b := range getLogIndexBuckets("syslog", "s:192.168.1.1", bucket, 0, now) {
+		if b+bucket <= oldest {
+			t.Errorf("index of evicted logs bucket=%d oldest=%d", b, oldest)
+		}
+	}
+	// Compaction is run by DB maintenance
+	if checkDBMaintenanceJob(time.Now(), time.Now(), time.Now()) != dbMaintenanceCompact {
+		t.Error("compaction must be requested after evict")
+	}
+}
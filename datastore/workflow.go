@@ -0,0 +1,168 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Workflow of notify or incident.
+// Target is "incident:<incident id>" or "notify:<time>:<rule id>".
+
+var NotifyStates = []string{"new", "acknowledged", "investigating", "resolved", "false-positive"}
+
+type NotifyWorkflowEnt struct {
+	Target   string
+	State    string
+	Assignee string
+	Comments []NotifyCommentEnt
+	History  []NotifyHistoryEnt
+	Updated  int64
+}
+
+type NotifyCommentEnt struct {
+	Time int64
+	User string
+	Text string
+}
+
+type NotifyHistoryEnt struct {
+	Time  int64
+	User  string
+	State string
+	// Assignee is "-" when unassigned and empty when not changed.
+	Assignee string
+	Comment  string
+}
+
+// GetNotifyWorkflowTarget returns workflow target of notify.
+// Notify in incident shares workflow of incident.
+func GetNotifyWorkflowTarget(n *NotifyEnt) string {
+	if n.IncidentID != "" {
+		return "incident:" + n.IncidentID
+	}
+	return getNotifyKey(n.Time, n.ID)
+}
+
+// IsOpenNotifyState returns true when state needs action.
+func IsOpenNotifyState(s string) bool {
+	return s != "resolved" && s != "false-positive"
+}
+
+func IsValidNotifyState(s string) bool {
+	for _, v := range NotifyStates {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNotifyWorkflow returns workflow of target. New workflow is returned if not found.
+func GetNotifyWorkflow(target string) *NotifyWorkflowEnt {
+	ret := &NotifyWorkflowEnt{Target: target, State: "new"}
+	db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte("workflow:" + target))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(v []byte) error {
+			return json.Unmarshal(v, ret)
+		})
+	})
+	return ret
+}
+
+// UpdateNotifyWorkflow changes state, assignee or adds comment. Empty value is not changed.
+// Assignee "-" is unassign. Update without change is not saved.
+func UpdateNotifyWorkflow(target, user, state, assignee, comment string) (*NotifyWorkflowEnt, error) {
+	if state != "" && !IsValidNotifyState(state) {
+		return nil, fmt.Errorf("invalid state %s", state)
+	}
+	if !existNotifyWorkflowTarget(target) {
+		return nil, fmt.Errorf("notify not found %s", target)
+	}
+	now := time.Now().UnixNano()
+	k := []byte("workflow:" + target)
+	var w *NotifyWorkflowEnt
+	var err error
+	// Read and update workflow in one transaction and retry on conflict
+	for i := 0; i < 3; i++ {
+		err = db.Update(func(txn *badger.Txn) error {
+			w = &NotifyWorkflowEnt{Target: target, State: "new"}
+			item, err := txn.Get(k)
+			if err == nil {
+				if err := item.Value(func(v []byte) error {
+					return json.Unmarshal(v, w)
+				}); err != nil {
+					return err
+				}
+			} else if err != badger.ErrKeyNotFound {
+				return err
+			}
+			if !updateNotifyWorkflow(w, now, user, state, assignee, comment) {
+				return nil
+			}
+			v, err := json.Marshal(w)
+			if err != nil {
+				return err
+			}
+			e := badger.NewEntry(k, v).WithTTL(time.Hour * 24 * time.Duration(Config.NotifyRetention))
+			return txn.SetEntry(e)
+		})
+		if err != badger.ErrConflict {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// updateNotifyWorkflow applies change to workflow and adds history.
+// It returns false when nothing is changed.
+func updateNotifyWorkflow(w *NotifyWorkflowEnt, now int64, user, state, assignee, comment string) bool {
+	h := NotifyHistoryEnt{
+		Time:    now,
+		User:    user,
+		Comment: comment,
+	}
+	if state != "" && state != w.State {
+		w.State = state
+		h.State = state
+	}
+	if assignee == "-" {
+		// Unassign
+		if w.Assignee != "" {
+			w.Assignee = ""
+			h.Assignee = "-"
+		}
+	} else if assignee != "" && assignee != w.Assignee {
+		w.Assignee = assignee
+		h.Assignee = assignee
+	}
+	if h.State == "" && h.Assignee == "" && comment == "" {
+		return false
+	}
+	if comment != "" {
+		w.Comments = append(w.Comments, NotifyCommentEnt{Time: now, User: user, Text: comment})
+	}
+	w.History = append(w.History, h)
+	w.Updated = now
+	return true
+}
+
+// existNotifyWorkflowTarget checks notify or incident of target. Target is same as DB key.
+func existNotifyWorkflowTarget(target string) bool {
+	if !strings.HasPrefix(target, "notify:") && !strings.HasPrefix(target, "incident:") {
+		return false
+	}
+	return db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte(target))
+		return err
+	}) == nil
+}
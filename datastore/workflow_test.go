@@ -0,0 +1,91 @@
+package datastore
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNotifyWorkflow(t *testing.T) {
+	// Use in-memory DB
+	Config.DBPath = ""
+	Config.NotifyRetention = 1
+	OpenDB()
+	defer CloseDB()
+
+	n := &NotifyEnt{Time: time.Now().UnixNano(), ID: "rule1", Level: "high", Title: "test"}
+	SaveNotify(n)
+	target := GetNotifyWorkflowTarget(n)
+	if w := GetNotifyWorkflow(target); w.State != "new" || len(w.History) != 0 {
+		t.Errorf("invalid new workflow %+v", w)
+	}
+	if _, err := UpdateNotifyWorkflow(target, "user1", "closed", "", ""); err == nil {
+		t.Error("invalid state must be error")
+	}
+	if _, err := UpdateNotifyWorkflow("notify:0000000000000000:none", "user1", "acknowledged", "", ""); err == nil {
+		t.Error("unknown target must be error")
+	}
+	if _, err := UpdateNotifyWorkflow(target, "user1", "acknowledged", "user2", "checking"); err != nil {
+		t.Fatalf("update workflow err=%v", err)
+	}
+	if _, err := UpdateNotifyWorkflow(target, "user2", "", "", "false alarm"); err != nil {
+		t.Fatalf("add comment err=%v", err)
+	}
+	if _, err := UpdateNotifyWorkflow(target, "user2", "false-positive", "-", ""); err != nil {
+		t.Fatalf("resolve err=%v", err)
+	}
+	w := GetNotifyWorkflow(target)
+	if w.State != "false-positive" || w.Assignee != "" || len(w.Comments) != 2 || len(w.History) != 3 {
+		t.Errorf("invalid workflow %+v", w)
+	}
+	if w.History[0].State != "acknowledged" || w.History[0].Assignee != "user2" || w.History[1].State != "" || w.History[2].User != "user2" ||
+		w.History[1].Assignee != "" || w.History[2].Assignee != "-" {
+		t.Errorf("invalid history %+v", w.History)
+	}
+	// Update without change is not saved
+	if _, err := UpdateNotifyWorkflow(target, "user2", "false-positive", "-", ""); err != nil {
+		t.Fatalf("update without change err=%v", err)
+	}
+	if w := GetNotifyWorkflow(target); len(w.History) != 3 || w.Updated != w.History[2].Time {
+		t.Errorf("update without change is saved %+v", w.History)
+	}
+	if IsOpenNotifyState(w.State) || !IsOpenNotifyState("investigating") {
+		t.Error("invalid open state")
+	}
+
+	// Concurrent updates must not lose comments
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	ok := 0
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := UpdateNotifyWorkflow(target, "user3", "", "", fmt.Sprintf("comment %d", i)); err == nil {
+				mu.Lock()
+				ok++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if w := GetNotifyWorkflow(target); ok < 1 || len(w.Comments) != 2+ok || len(w.History) != 3+ok {
+		t.Errorf("lost update ok=%d comments=%d history=%d", ok, len(w.Comments), len(w.History))
+	}
+
+	// Notify in incident shares workflow of incident
+	i := &IncidentEnt{ID: NewIncidentID(n.Time), Notify: *n, Count: 1}
+	SaveIncident(i)
+	n.IncidentID = i.ID
+	if target = GetNotifyWorkflowTarget(n); target != "incident:"+i.ID {
+		t.Errorf("invalid incident target %s", target)
+	}
+	if _, err := UpdateNotifyWorkflow(target, "user1", "investigating", "", ""); err != nil {
+		t.Errorf("update incident workflow err=%v", err)
+	}
+	ClearNotify()
+	if w := GetNotifyWorkflow(target); w.State != "new" {
+		t.Errorf("workflow not cleared %+v", w)
+	}
+}
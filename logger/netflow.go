@@ -49,8 +49,8 @@ func StartNetFlowd(ctx context.Context, wg *sync.WaitGroup) {
 		log.Fatalf("netflowd err=%v", err)
 	}
 	go func() {
+		decoders := make(map[string]*netflow.Decoder)
 		for {
-			decoders := make(map[string]*netflow.Decoder)
 			buf := make([]byte, 8192)
 			var remote *net.UDPAddr
 			var octets int
@@ -63,6 +63,7 @@ func StartNetFlowd(ctx context.Context, wg *sync.WaitGroup) {
 				d = netflow.NewDecoder(s)
 				decoders[remote.String()] = d
 			}
+			parseNetflowOptions(buf[:octets], remote.IP.String())
 			m, err := d.Read(bytes.NewBuffer(buf[:octets]))
 			if err != nil {
 				log.Printf("netflowd err=%v", err)
@@ -102,6 +103,7 @@ func StartNetFlowd(ctx context.Context, wg *sync.WaitGroup) {
 }
 
 func logIPFIX(p *ipfix.Message, src string) {
+	key := fmt.Sprintf("%s:%d", src, p.Header.ObservationDomainID)
 	for _, ds := range p.DataSets {
 		if ds.Records == nil {
 			continue
@@ -195,6 +197,8 @@ func logIPFIX(p *ipfix.Message, src string) {
 					}
 				}
 			}
+			setNetflowSampling(record, src, key)
+			setNetflowZone(record)
 			s, err := json.Marshal(record)
 			if err != nil {
 				continue
@@ -207,6 +211,7 @@ func logIPFIX(p *ipfix.Message, src string) {
 			}
 			reporter.SendNetflow(&datastore.NetflowLogEnt{
 				Time: time.Now().UnixNano(),
+				Src:  src,
 				Log:  record,
 			})
 		}
@@ -214,13 +219,17 @@ func logIPFIX(p *ipfix.Message, src string) {
 }
 
 func logNetflow(p *netflow5.Packet, src string) {
-	var record = make(map[string]interface{})
+	// Lower 14 bits are sampling interval
+	sampling := p.Header.SamplingInterval & 0x3fff
 	for _, r := range p.Records {
+		var record = make(map[string]interface{})
 		record["srcAddr"] = r.SrcAddr
 		record["srcPort"] = r.SrcPort
 		record["dstAddr"] = r.DstAddr
 		record["dstPort"] = r.DstPort
 		record["nextHop"] = r.NextHop
+		record["input"] = r.Input
+		record["output"] = r.Output
 		record["bytes"] = r.Bytes
 		record["packets"] = r.Packets
 		record["first"] = r.First
@@ -256,6 +265,11 @@ func logNetflow(p *netflow5.Packet, src string) {
 				}
 			}
 		}
+		if sampling > 0 {
+			record["samplingInterval"] = sampling
+		}
+		setNetflowSampling(record, src, src)
+		setNetflowZone(record)
 		s, err := json.Marshal(record)
 		if err != nil {
 			log.Println(err)
@@ -269,6 +283,7 @@ func logNetflow(p *netflow5.Packet, src string) {
 		}
 		reporter.SendNetflow(&datastore.NetflowLogEnt{
 			Time: time.Now().UnixNano(),
+			Src:  src,
 			Log:  record,
 		})
 
@@ -276,6 +291,7 @@ func logNetflow(p *netflow5.Packet, src string) {
 }
 
 func logNetflow9(p *netflow9.Packet, src string) {
+	key := fmt.Sprintf("%s:%d", src, p.Header.SourceID)
 	for _, ds := range p.DataFlowSets {
 		if ds.Records == nil {
 			continue
@@ -367,6 +383,8 @@ func logNetflow9(p *netflow9.Packet, src string) {
 					}
 				}
 			}
+			setNetflowSampling(record, src, key)
+			setNetflowZone(record)
 			s, err := json.Marshal(record)
 			if err != nil {
 				continue
@@ -379,8 +397,25 @@ func logNetflow9(p *netflow9.Packet, src string) {
 			}
 			reporter.SendNetflow(&datastore.NetflowLogEnt{
 				Time: time.Now().UnixNano(),
+				Src:  src,
 				Log:  record,
 			})
 		}
 	}
 }
+
+// setNetflowZone sets srcZone and dstZone of flow record.
+func setNetflowZone(record map[string]interface{}) {
+	for _, k := range []string{"srcAddr", "sourceIPv4Address", "sourceIPv6Address"} {
+		if ip, ok := record[k].(net.IP); ok {
+			record["srcZone"] = datastore.GetZone(ip.String())
+			break
+		}
+	}
+	for _, k := range []string{"dstAddr", "destinationIPv4Address", "destinationIPv6Address"} {
+		if ip, ok := record[k].(net.IP); ok {
+			record["dstZone"] = datastore.GetZone(ip.String())
+			break
+		}
+	}
+}
@@ -0,0 +1,338 @@
+package logger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// The netflow library drops options templates and their data.
+// Parse them here to get sampling rate and interface names of exporters.
+
+type netflowFieldSpec struct {
+	Type   uint16
+	Length uint16
+}
+
+type netflowOptionTemplate struct {
+	Scopes []netflowFieldSpec
+	Fields []netflowFieldSpec
+}
+
+type netflowExporterEnt struct {
+	Templates map[uint16]*netflowOptionTemplate
+	// Sampling interval without sampler ID
+	Sampling  int64
+	Algorithm int64
+	Samplers  map[int64]int64
+	IfNames   map[int64]string
+}
+
+var netflowExporterMap = make(map[string]*netflowExporterEnt)
+
+func getNetflowExporter(key string) *netflowExporterEnt {
+	e, ok := netflowExporterMap[key]
+	if !ok {
+		e = &netflowExporterEnt{
+			Templates: make(map[uint16]*netflowOptionTemplate),
+			Samplers:  make(map[int64]int64),
+			IfNames:   make(map[int64]string),
+		}
+		netflowExporterMap[key] = e
+	}
+	return e
+}
+
+// parseNetflowOptions parses options templates and options data of NetFlow v9 and IPFIX.
+func parseNetflowOptions(buf []byte, src string) {
+	if len(buf) < 4 {
+		return
+	}
+	switch binary.BigEndian.Uint16(buf[0:2]) {
+	case 9:
+		if len(buf) < 20 {
+			return
+		}
+		e := getNetflowExporter(fmt.Sprintf("%s:%d", src, binary.BigEndian.Uint32(buf[16:20])))
+		parseNetflowSets(e, buf[20:], false)
+	case 10:
+		if len(buf) < 16 {
+			return
+		}
+		e := getNetflowExporter(fmt.Sprintf("%s:%d", src, binary.BigEndian.Uint32(buf[12:16])))
+		parseNetflowSets(e, buf[16:], true)
+	}
+}
+
+func parseNetflowSets(e *netflowExporterEnt, buf []byte, ipfix bool) {
+	for len(buf) >= 4 {
+		id := binary.BigEndian.Uint16(buf[0:2])
+		l := int(binary.BigEndian.Uint16(buf[2:4]))
+		if l < 4 || l > len(buf) {
+			return
+		}
+		data := buf[4:l]
+		buf = buf[l:]
+		switch {
+		case !ipfix && id == 1:
+			parseNetflow9OptionTemplates(e, data)
+		case ipfix && id == 3:
+			parseIPFIXOptionTemplates(e, data)
+		case id >= 256:
+			if t, ok := e.Templates[id]; ok {
+				parseNetflowOptionData(e, t, data, ipfix)
+			}
+		}
+	}
+}
+
+func parseNetflow9OptionTemplates(e *netflowExporterEnt, data []byte) {
+	for len(data) >= 6 {
+		id := binary.BigEndian.Uint16(data[0:2])
+		sl := int(binary.BigEndian.Uint16(data[2:4]))
+		ol := int(binary.BigEndian.Uint16(data[4:6]))
+		if id < 256 || 6+sl+ol > len(data) {
+			return
+		}
+		data = data[6:]
+		t := &netflowOptionTemplate{}
+		for i := 0; i+4 <= sl; i += 4 {
+			t.Scopes = append(t.Scopes, netflowFieldSpec{
+				Type:   binary.BigEndian.Uint16(data[i : i+2]),
+				Length: binary.BigEndian.Uint16(data[i+2 : i+4]),
+			})
+		}
+		data = data[sl:]
+		for i := 0; i+4 <= ol; i += 4 {
+			t.Fields = append(t.Fields, netflowFieldSpec{
+				Type:   binary.BigEndian.Uint16(data[i : i+2]),
+				Length: binary.BigEndian.Uint16(data[i+2 : i+4]),
+			})
+		}
+		data = data[ol:]
+		e.Templates[id] = t
+	}
+}
+
+func parseIPFIXOptionTemplates(e *netflowExporterEnt, data []byte) {
+	for len(data) >= 6 {
+		id := binary.BigEndian.Uint16(data[0:2])
+		fc := int(binary.BigEndian.Uint16(data[2:4]))
+		sc := int(binary.BigEndian.Uint16(data[4:6]))
+		if id < 256 || sc > fc {
+			return
+		}
+		data = data[6:]
+		t := &netflowOptionTemplate{}
+		for i := 0; i < fc; i++ {
+			if len(data) < 4 {
+				return
+			}
+			f := netflowFieldSpec{
+				Type:   binary.BigEndian.Uint16(data[0:2]),
+				Length: binary.BigEndian.Uint16(data[2:4]),
+			}
+			data = data[4:]
+			if f.Type&0x8000 != 0 {
+				// Enterprise number
+				if len(data) < 4 {
+					return
+				}
+				data = data[4:]
+				f.Type = 0
+			}
+			if i < sc {
+				t.Scopes = append(t.Scopes, f)
+			} else {
+				t.Fields = append(t.Fields, f)
+			}
+		}
+		e.Templates[id] = t
+	}
+}
+
+func parseNetflowOptionData(e *netflowExporterEnt, t *netflowOptionTemplate, data []byte, ipfix bool) {
+	specs := append(append([]netflowFieldSpec{}, t.Scopes...), t.Fields...)
+	size := 0
+	for _, f := range specs {
+		if f.Length == 0xffff {
+			size++
+		} else {
+			size += int(f.Length)
+		}
+	}
+	if size < 1 {
+		return
+	}
+	// Rest is padding
+	for len(data) >= size {
+		values := make(map[uint16][]byte)
+		var ifIndex int64 = -1
+		for i, f := range specs {
+			l := int(f.Length)
+			if ipfix && f.Length == 0xffff {
+				// Variable length
+				if len(data) < 1 {
+					return
+				}
+				l = int(data[0])
+				data = data[1:]
+				if l == 255 {
+					if len(data) < 2 {
+						return
+					}
+					l = int(binary.BigEndian.Uint16(data[0:2]))
+					data = data[2:]
+				}
+			}
+			if l < 1 || l > len(data) {
+				return
+			}
+			v := data[:l]
+			data = data[l:]
+			if !ipfix && i < len(t.Scopes) {
+				// NetFlow v9 scope type 2 = Interface
+				if f.Type == 2 {
+					ifIndex = getNetflowOptionInt(v)
+				}
+				continue
+			}
+			values[f.Type] = v
+		}
+		if v, ok := values[10]; ok {
+			ifIndex = getNetflowOptionInt(v)
+		}
+		name := ""
+		if v, ok := values[82]; ok {
+			name = strings.TrimRight(string(v), "\x00 ")
+		} else if v, ok := values[83]; ok {
+			name = strings.TrimRight(string(v), "\x00 ")
+		}
+		if ifIndex >= 0 && name != "" {
+			e.IfNames[ifIndex] = name
+		}
+		var interval int64
+		if v, ok := values[34]; ok {
+			interval = getNetflowOptionInt(v)
+		} else if v, ok := values[50]; ok {
+			interval = getNetflowOptionInt(v)
+		} else if v, ok := values[305]; ok {
+			interval = getNetflowOptionInt(v)
+			if s, ok := values[306]; ok && interval > 0 {
+				// 1 out of (interval + space) packets
+				interval = (interval + getNetflowOptionInt(s)) / interval
+			}
+		}
+		if interval > 0 {
+			if v, ok := values[48]; ok {
+				e.Samplers[getNetflowOptionInt(v)] = interval
+			} else if v, ok := values[302]; ok {
+				e.Samplers[getNetflowOptionInt(v)] = interval
+			} else {
+				e.Sampling = interval
+			}
+		}
+		if v, ok := values[35]; ok {
+			e.Algorithm = getNetflowOptionInt(v)
+		} else if v, ok := values[49]; ok {
+			e.Algorithm = getNetflowOptionInt(v)
+		} else if v, ok := values[304]; ok {
+			e.Algorithm = getNetflowOptionInt(v)
+		}
+	}
+}
+
+func getNetflowOptionInt(b []byte) int64 {
+	if len(b) > 8 {
+		return 0
+	}
+	var r int64
+	for _, v := range b {
+		r = r<<8 | int64(v)
+	}
+	return r
+}
+
+// getNetflowSamplingRateByConfig returns sampling rate set by config.
+func getNetflowSamplingRateByConfig(src string) int64 {
+	var r int64
+	for _, s := range datastore.Config.NetflowSamplingRate {
+		a := strings.SplitN(s, "=", 2)
+		if len(a) == 1 {
+			if v, err := strconv.ParseInt(strings.TrimSpace(a[0]), 10, 64); err == nil {
+				r = v
+			}
+			continue
+		}
+		if strings.TrimSpace(a[0]) == src {
+			if v, err := strconv.ParseInt(strings.TrimSpace(a[1]), 10, 64); err == nil {
+				return v
+			}
+		}
+	}
+	return r
+}
+
+// setNetflowSampling sets sampling rate and interface names of flow record.
+func setNetflowSampling(record map[string]interface{}, src string, key string) {
+	e := getNetflowExporter(key)
+	var rate int64
+	if v, ok := record["samplingInterval"]; ok {
+		rate = getNetflowRecordInt(v)
+	} else if v, ok := record["samplerId"]; ok {
+		rate = e.Samplers[getNetflowRecordInt(v)]
+	} else if v, ok := record["selectorId"]; ok {
+		rate = e.Samplers[getNetflowRecordInt(v)]
+	}
+	if rate < 1 {
+		rate = e.Sampling
+	}
+	if rate < 1 {
+		rate = getNetflowSamplingRateByConfig(src)
+	}
+	if rate > 1 {
+		record["samplingRate"] = rate
+		if e.Algorithm > 0 {
+			record["samplingAlgorithm"] = e.Algorithm
+		}
+	}
+	for _, k := range []string{"ingressInterface", "input"} {
+		if v, ok := record[k]; ok {
+			if n, ok := e.IfNames[getNetflowRecordInt(v)]; ok {
+				record["inputIfName"] = n
+			}
+			break
+		}
+	}
+	for _, k := range []string{"egressInterface", "output"} {
+		if v, ok := record[k]; ok {
+			if n, ok := e.IfNames[getNetflowRecordInt(v)]; ok {
+				record["outputIfName"] = n
+			}
+			break
+		}
+	}
+}
+
+func getNetflowRecordInt(v interface{}) int64 {
+	switch n := v.(type) {
+	case uint8:
+		return int64(n)
+	case uint16:
+		return int64(n)
+	case uint32:
+		return int64(n)
+	case uint64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case []byte:
+		return getNetflowOptionInt(n)
+	}
+	return 0
+}
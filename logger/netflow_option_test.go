@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func TestNetflow9Options(t *testing.T) {
+	netflowExporterMap = make(map[string]*netflowExporterEnt)
+	buf := new(bytes.Buffer)
+	// Header
+	binary.Write(buf, binary.BigEndian, uint16(9))  // version
+	binary.Write(buf, binary.BigEndian, uint16(4))  // count
+	binary.Write(buf, binary.BigEndian, uint32(0))  // sysUpTime
+	binary.Write(buf, binary.BigEndian, uint32(0))  // unix_secs
+	binary.Write(buf, binary.BigEndian, uint32(0))  // sequence
+	binary.Write(buf, binary.BigEndian, uint32(10)) // source id
+	// Options template flowset
+	binary.Write(buf, binary.BigEndian, uint16(1))  // flowset id
+	binary.Write(buf, binary.BigEndian, uint16(40)) // length
+	// Sampler options: scope system, sampler id, interval, algorithm
+	binary.Write(buf, binary.BigEndian, uint16(256)) // template id
+	binary.Write(buf, binary.BigEndian, uint16(4))   // scope length
+	binary.Write(buf, binary.BigEndian, uint16(12))  // option length
+	binary.Write(buf, binary.BigEndian, []uint16{1, 4, 48, 1, 34, 4, 35, 1})
+	// Interface options: scope interface, ifName
+	binary.Write(buf, binary.BigEndian, uint16(257)) // template id
+	binary.Write(buf, binary.BigEndian, uint16(4))   // scope length
+	binary.Write(buf, binary.BigEndian, uint16(4))   // option length
+	binary.Write(buf, binary.BigEndian, []uint16{2, 4, 82, 8})
+	// Sampler data
+	binary.Write(buf, binary.BigEndian, uint16(256)) // flowset id
+	binary.Write(buf, binary.BigEndian, uint16(16))  // length
+	binary.Write(buf, binary.BigEndian, uint32(0))   // system
+	binary.Write(buf, binary.BigEndian, uint8(1))    // sampler id
+	binary.Write(buf, binary.BigEndian, uint32(1000))
+	binary.Write(buf, binary.BigEndian, uint8(2))
+	binary.Write(buf, binary.BigEndian, uint16(0)) // padding
+	// Interface data
+	binary.Write(buf, binary.BigEndian, uint16(257)) // flowset id
+	binary.Write(buf, binary.BigEndian, uint16(28))  // length
+	binary.Write(buf, binary.BigEndian, uint32(1))
+	buf.Write([]byte("Gi0/1\x00\x00\x00"))
+	binary.Write(buf, binary.BigEndian, uint32(2))
+	buf.Write([]byte("Gi0/2\x00\x00\x00"))
+
+	parseNetflowOptions(buf.Bytes(), "192.168.1.1")
+
+	e, ok := netflowExporterMap["192.168.1.1:10"]
+	if !ok {
+		t.Fatal("exporter not found")
+	}
+	if e.Samplers[1] != 1000 {
+		t.Errorf("invalid sampler %+v", e.Samplers)
+	}
+	if e.Algorithm != 2 {
+		t.Errorf("invalid algorithm %d", e.Algorithm)
+	}
+	if e.IfNames[1] != "Gi0/1" || e.IfNames[2] != "Gi0/2" {
+		t.Errorf("invalid ifNames %+v", e.IfNames)
+	}
+
+	record := map[string]interface{}{
+		"samplerId":        uint8(1),
+		"ingressInterface": uint32(1),
+		"egressInterface":  uint32(2),
+	}
+	setNetflowSampling(record, "192.168.1.1", "192.168.1.1:10")
+	if record["samplingRate"] != int64(1000) {
+		t.Errorf("invalid samplingRate %v", record["samplingRate"])
+	}
+	if record["inputIfName"] != "Gi0/1" || record["outputIfName"] != "Gi0/2" {
+		t.Errorf("invalid ifName %v %v", record["inputIfName"], record["outputIfName"])
+	}
+
+	// Config rate for unknown exporter
+	datastore.Config.NetflowSamplingRate = []string{"100", "192.168.1.2=512"}
+	defer func() {
+		datastore.Config.NetflowSamplingRate = []string{}
+	}()
+	record = map[string]interface{}{}
+	setNetflowSampling(record, "192.168.1.2", "192.168.1.2:0")
+	if record["samplingRate"] != int64(512) {
+		t.Errorf("invalid samplingRate %v", record["samplingRate"])
+	}
+	record = map[string]interface{}{}
+	setNetflowSampling(record, "192.168.1.3", "192.168.1.3:0")
+	if record["samplingRate"] != int64(100) {
+		t.Errorf("invalid samplingRate %v", record["samplingRate"])
+	}
+}
@@ -0,0 +1,341 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// Response action is space separated "key=value" list like
+// name=block type=exec cmd=/usr/local/bin/block.sh arg={{.Src}} arg={{.ID}} timeout=10s
+// name=fw type=http url=https://fw.example.com/api/block?ip={{urlquery .Src}} method=POST bearer=token
+// name=blocklist type=file path=/etc/twlogeye/blocklist.txt line={{.Src}} unique=true
+// arg, url and line are templates of notify. Actions run on routes with action=<name>.
+
+type notifyActionEnt struct {
+	Name    string
+	Type    string
+	DryRun  bool
+	Timeout time.Duration
+	// exec
+	Cmd  string
+	Args []*template.Template
+	// http
+	Method  string
+	URL     *template.Template
+	Webhook *webhookDstEnt
+	// file
+	Path   string
+	Line   *template.Template
+	Unique bool
+}
+
+const maxActionOutput = 1024
+
+// Max number of actions running at the same time
+var actionSem = make(chan bool, 10)
+var actionFileMu sync.Mutex
+
+func getNotifyActions(list []string) []*notifyActionEnt {
+	ret := []*notifyActionEnt{}
+	for i, s := range list {
+		a, err := parseNotifyAction(s)
+		if err != nil {
+			log.Fatalf("invalid notify action %s err=%v", s, err)
+		}
+		if a.Name == "" {
+			a.Name = fmt.Sprintf("action%d", i+1)
+		}
+		ret = append(ret, a)
+	}
+	return ret
+}
+
+func parseNotifyAction(s string) (*notifyActionEnt, error) {
+	a := &notifyActionEnt{
+		Timeout: time.Second * 10,
+		Method:  "POST",
+		Webhook: &webhookDstEnt{Headers: make(map[string]string)},
+	}
+	webhookOpts := []string{}
+	parse := func(v string) (*template.Template, error) {
+		return template.New(a.Name).Funcs(notifyTemplateFuncs).Parse(v)
+	}
+	for _, f := range splitActionFields(s) {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid field %s", f)
+		}
+		var err error
+		switch kv[0] {
+		case "name":
+			a.Name = kv[1]
+		case "type":
+			a.Type = kv[1]
+		case "dryrun":
+			a.DryRun = kv[1] == "true"
+		case "timeout":
+			if a.Timeout, err = time.ParseDuration(kv[1]); err != nil || a.Timeout <= 0 {
+				return nil, fmt.Errorf("invalid timeout %s", kv[1])
+			}
+			webhookOpts = append(webhookOpts, f)
+		case "cmd":
+			a.Cmd = kv[1]
+		case "arg":
+			t, err := parse(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			a.Args = append(a.Args, t)
+		case "url":
+			if a.URL, err = parse(kv[1]); err != nil {
+				return nil, err
+			}
+		case "method":
+			a.Method = strings.ToUpper(kv[1])
+		case "template":
+			if a.Webhook.Template, err = getNotifyTemplate(kv[1]); err != nil {
+				return nil, err
+			}
+		case "secret", "bearer", "basic", "header", "ca", "cert", "key", "insecure":
+			webhookOpts = append(webhookOpts, f)
+		case "path":
+			a.Path = kv[1]
+		case "line":
+			if a.Line, err = parse(kv[1]); err != nil {
+				return nil, err
+			}
+		case "unique":
+			a.Unique = kv[1] == "true"
+		default:
+			return nil, fmt.Errorf("unknown field %s", kv[0])
+		}
+	}
+	switch a.Type {
+	case "exec":
+		if a.Cmd == "" {
+			return nil, fmt.Errorf("no cmd")
+		}
+	case "http":
+		if a.URL == nil {
+			return nil, fmt.Errorf("no url")
+		}
+		if err := a.Webhook.setOptions(webhookOpts); err != nil {
+			return nil, err
+		}
+	case "file":
+		if a.Path == "" || a.Line == nil {
+			return nil, fmt.Errorf("no path or line")
+		}
+	default:
+		return nil, fmt.Errorf("invalid type %s", a.Type)
+	}
+	return a, nil
+}
+
+// splitActionFields splits fields by space. Spaces in {{ }} are kept.
+func splitActionFields(s string) []string {
+	ret := []string{}
+	st := 0
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "{{"):
+			depth++
+			i++
+		case depth > 0 && strings.HasPrefix(s[i:], "}}"):
+			depth--
+			i++
+		case depth == 0 && (s[i] == ' ' || s[i] == '\t'):
+			if i > st {
+				ret = append(ret, s[st:i])
+			}
+			st = i + 1
+		}
+	}
+	if st < len(s) {
+		ret = append(ret, s[st:])
+	}
+	return ret
+}
+
+func findNotifyAction(list []*notifyActionEnt, name string) *notifyActionEnt {
+	for _, a := range list {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// runNotifyActions runs actions in background and saves results to notify.
+func runNotifyActions(list []*notifyActionEnt, names []string, n *datastore.NotifyEnt, wg *sync.WaitGroup) {
+	for _, name := range names {
+		a := findNotifyAction(list, name)
+		if a == nil {
+			continue
+		}
+		nc := *n
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actionSem <- true
+			defer func() { <-actionSem }()
+			r := a.run(&nc)
+			datastore.AddNotifyAction(nc.Time, nc.ID, r)
+			log.Printf("notify action %s %s %s code=%d err=%s", a.Name, nc.ID, r.Status, r.Code, r.Error)
+		}()
+	}
+}
+
+func (a *notifyActionEnt) run(n *datastore.NotifyEnt) *datastore.NotifyActionEnt {
+	st := time.Now()
+	r := &datastore.NotifyActionEnt{
+		Name:   a.Name,
+		Type:   a.Type,
+		Status: "ok",
+		Time:   st.UnixNano(),
+	}
+	var err error
+	if a.DryRun || datastore.Config.NotifyActionDryRun {
+		r.Status = "dry-run"
+		r.Output, err = a.describe(n)
+	} else {
+		switch a.Type {
+		case "exec":
+			r.Code, r.Output, err = a.exec(n)
+		case "http":
+			r.Code, err = a.http(n)
+		case "file":
+			err = a.appendFile(n)
+		}
+	}
+	if err != nil {
+		r.Status = "error"
+		r.Error = err.Error()
+	}
+	if len(r.Output) > maxActionOutput {
+		r.Output = r.Output[:maxActionOutput]
+	}
+	r.Duration = time.Since(st).Milliseconds()
+	return r
+}
+
+func renderAction(t *template.Template, n *datastore.NotifyEnt) (string, error) {
+	b := new(bytes.Buffer)
+	if err := t.Execute(b, getNotifyTemplateData(n)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// describe returns what action will do.
+func (a *notifyActionEnt) describe(n *datastore.NotifyEnt) (string, error) {
+	switch a.Type {
+	case "exec":
+		args, err := a.getArgs(n)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(append([]string{a.Cmd}, args...), " "), nil
+	case "http":
+		u, err := renderAction(a.URL, n)
+		if err != nil {
+			return "", err
+		}
+		return a.Method + " " + u, nil
+	case "file":
+		l, err := renderAction(a.Line, n)
+		if err != nil {
+			return "", err
+		}
+		return a.Path + ": " + l, nil
+	}
+	return "", nil
+}
+
+func (a *notifyActionEnt) getArgs(n *datastore.NotifyEnt) ([]string, error) {
+	args := []string{}
+	for _, t := range a.Args {
+		s, err := renderAction(t, n)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, s)
+	}
+	return args, nil
+}
+
+// exec runs command without shell and returns exit code and output.
+func (a *notifyActionEnt) exec(n *datastore.NotifyEnt) (int, string, error) {
+	args, err := a.getArgs(n)
+	if err != nil {
+		return 0, "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.Timeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, a.Cmd, args...).CombinedOutput()
+	if ctx.Err() != nil {
+		return -1, string(out), fmt.Errorf("timeout %s", a.Timeout)
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), string(out), err
+	}
+	return 0, string(out), err
+}
+
+func (a *notifyActionEnt) http(n *datastore.NotifyEnt) (int, error) {
+	u, err := renderAction(a.URL, n)
+	if err != nil {
+		return 0, err
+	}
+	return a.Webhook.request(a.Method, u, n)
+}
+
+// appendFile appends line to file. Existing line is skipped if unique.
+// Line with CR or LF is refused not to add other lines to file.
+func (a *notifyActionEnt) appendFile(n *datastore.NotifyEnt) error {
+	l, err := renderAction(a.Line, n)
+	if err != nil {
+		return err
+	}
+	l = strings.TrimRight(l, "\r\n")
+	if strings.ContainsAny(l, "\r\n") {
+		return fmt.Errorf("line has newline %q", l)
+	}
+	actionFileMu.Lock()
+	defer actionFileMu.Unlock()
+	if a.Unique {
+		if f, err := os.Open(a.Path); err == nil {
+			s := bufio.NewScanner(f)
+			for s.Scan() {
+				if s.Text() == l {
+					f.Close()
+					return nil
+				}
+			}
+			f.Close()
+		}
+	}
+	f, err := os.OpenFile(a.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(l + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,166 @@
+package notify
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func getTestActionResult(t *testing.T, s string) *datastore.NotifyActionEnt {
+	a, err := parseNotifyAction(s)
+	if err != nil {
+		t.Fatalf("parse action %s err=%v", s, err)
+	}
+	return a.run(getTestNotify())
+}
+
+func TestParseNotifyAction(t *testing.T) {
+	for _, s := range []string{
+		"name=a",
+		"type=exec",
+		"type=http method=POST",
+		"type=file path=/tmp/x",
+		"type=exec cmd=echo arg={{.Src",
+		"type=exec cmd=echo timeout=x",
+		"type=exec cmd=echo unknown=1",
+		"type=http url=http://localhost header=x",
+	} {
+		if _, err := parseNotifyAction(s); err == nil {
+			t.Errorf("no error %s", s)
+		}
+	}
+	list := getNotifyActions([]string{"type=exec cmd=echo", "name=block type=file path=/tmp/x line={{.Src}}"})
+	if list[0].Name != "action1" || findNotifyAction(list, "block") == nil {
+		t.Errorf("invalid actions %+v", list)
+	}
+}
+
+func TestExecAction(t *testing.T) {
+	r := getTestActionResult(t, "type=exec cmd=echo arg={{.Src}} arg={{.ID}}")
+	if r.Status != "ok" || r.Code != 0 || r.Output != "192.168.1.1 rule1\n" {
+		t.Errorf("invalid exec result %+v", r)
+	}
+	r = getTestActionResult(t, "type=exec cmd=false")
+	if r.Status != "error" || r.Code != 1 {
+		t.Errorf("invalid exit code %+v", r)
+	}
+	r = getTestActionResult(t, "type=exec cmd=sleep arg=5 timeout=100ms")
+	if r.Status != "error" || r.Code != -1 || !strings.Contains(r.Error, "timeout") || r.Duration > 3000 {
+		t.Errorf("invalid timeout result %+v", r)
+	}
+	r = getTestActionResult(t, "type=exec cmd=/not/found")
+	if r.Status != "error" {
+		t.Errorf("invalid not found result %+v", r)
+	}
+}
+
+func TestHTTPAction(t *testing.T) {
+	ch := make(chan *http.Request, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		ch <- r
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+	r := getTestActionResult(t, "type=http method=put url="+ts.URL+"/block?ip={{urlquery .Src}} bearer=token")
+	if r.Status != "ok" || r.Code != http.StatusCreated {
+		t.Errorf("invalid http result %+v", r)
+	}
+	req := <-ch
+	if req.Method != "PUT" || req.URL.Query().Get("ip") != "192.168.1.1" || req.Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("invalid request %s %s %v", req.Method, req.URL, req.Header)
+	}
+}
+
+func TestFileAction(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "blocklist.txt")
+	for i := 0; i < 2; i++ {
+		if r := getTestActionResult(t, "type=file path="+p+" line={{.Src}} unique=true"); r.Status != "ok" {
+			t.Errorf("invalid file result %+v", r)
+		}
+	}
+	getTestActionResult(t, "type=file path="+p+" line={{.ID}}")
+	// Line with newline is refused
+	if r := getTestActionResult(t, "type=file path="+p+" line={{.ID}}{{printf \"\\n10.0.0.1\"}}"); r.Status == "ok" {
+		t.Errorf("line with newline must be error %+v", r)
+	}
+	if b, err := os.ReadFile(p); err != nil || string(b) != "192.168.1.1\nrule1\n" {
+		t.Errorf("invalid file %q err=%v", b, err)
+	}
+}
+
+func TestRunNotifyActions(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NotifyRetention = 1
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	p := filepath.Join(t.TempDir(), "blocklist.txt")
+	list := getNotifyActions([]string{
+		"name=echo type=exec cmd=echo arg={{.Src}}",
+		"name=block type=file path=" + p + " line={{.Src}}",
+	})
+	routes := getNotifyRoutes([]string{"name=soc level=high action=echo|block", "name=default"})
+	n := getTestNotify()
+	n.Time = time.Now().UnixNano()
+	datastore.SaveNotify(n)
+	_, _, acts := selectNotifyRoute(routes, nil, n, time.Now())
+	if len(acts) != 2 {
+		t.Fatalf("invalid route actions %v", acts)
+	}
+	// Dry run
+	datastore.Config.NotifyActionDryRun = true
+	defer func() { datastore.Config.NotifyActionDryRun = false }()
+	wg := &sync.WaitGroup{}
+	runNotifyActions(list, acts, n, wg)
+	wg.Wait()
+	if _, err := os.Stat(p); err == nil {
+		t.Error("file action run in dry-run mode")
+	}
+	found := 0
+	datastore.ForEachNotify(n.Time, n.Time, func(e *datastore.NotifyEnt) bool {
+		for _, a := range e.Actions {
+			if a.Status != "dry-run" {
+				t.Errorf("invalid dry-run result %+v", a)
+			}
+			switch a.Name {
+			case "echo":
+				if a.Output != "echo 192.168.1.1" {
+					t.Errorf("invalid dry-run output %s", a.Output)
+				}
+				found++
+			case "block":
+				if a.Output != p+": 192.168.1.1" {
+					t.Errorf("invalid dry-run output %s", a.Output)
+				}
+				found++
+			}
+		}
+		return true
+	})
+	if found != 2 {
+		t.Errorf("action results not saved %d", found)
+	}
+	n.Level = "low"
+	if _, _, acts := selectNotifyRoute(routes, nil, n, time.Now()); len(acts) != 0 {
+		t.Errorf("actions of unmatched route %v", acts)
+	}
+}
+
+func TestSplitActionFields(t *testing.T) {
+	f := splitActionFields("type=exec  cmd=echo arg={{ .Src }} arg=x{{printf \"%s %s\" .ID .Level}}")
+	if len(f) != 4 || f[2] != "arg={{ .Src }}" || f[3] != `arg=x{{printf "%s %s" .ID .Level}}` {
+		t.Errorf("invalid fields %q", f)
+	}
+	f = splitActionFields("type=exec cmd=logger arg=遮断 arg={{ printf \"%s 遮断\" .Src }}")
+	if len(f) != 4 || f[2] != "arg=遮断" || f[3] != `arg={{ printf "%s 遮断" .Src }}` {
+		t.Errorf("invalid multibyte fields %q", f)
+	}
+}
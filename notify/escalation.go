@@ -0,0 +1,196 @@
+package notify
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// Escalation policy is space separated "key=value" list like
+// name=oncall level=critical route=soc step=15m step=30m/mail:1|webhook:2
+// step is "<delay>[/<dst>]". Delay is from the first notification.
+// Without dst the notification is re-sent to the destinations of the first notification.
+// The first matched policy is used. Escalation stops when the notification is acknowledged.
+
+type escalationStepEnt struct {
+	Delay time.Duration
+	Dst   []string
+}
+
+type escalationPolicyEnt struct {
+	Name   string
+	Levels []string
+	IDs    []string
+	Routes []string
+	Steps  []escalationStepEnt
+}
+
+func getEscalationPolicies(list []string) []*escalationPolicyEnt {
+	ret := []*escalationPolicyEnt{}
+	for i, s := range list {
+		p, err := parseEscalationPolicy(s)
+		if err != nil {
+			log.Fatalf("invalid notify escalation %s err=%v", s, err)
+		}
+		if p.Name == "" {
+			p.Name = fmt.Sprintf("escalation%d", i+1)
+		}
+		ret = append(ret, p)
+	}
+	return ret
+}
+
+func parseEscalationPolicy(s string) (*escalationPolicyEnt, error) {
+	p := &escalationPolicyEnt{}
+	for _, f := range strings.Fields(s) {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid field %s", f)
+		}
+		vals := strings.Split(kv[1], "|")
+		switch strings.ToLower(kv[0]) {
+		case "name":
+			p.Name = kv[1]
+		case "level":
+			for _, l := range vals {
+				if _, ok := levelOrder[strings.TrimSuffix(l, "+")]; !ok {
+					return nil, fmt.Errorf("invalid level %s", l)
+				}
+			}
+			p.Levels = vals
+		case "id":
+			p.IDs = vals
+		case "route":
+			p.Routes = vals
+		case "step":
+			a := strings.SplitN(kv[1], "/", 2)
+			d, err := time.ParseDuration(a[0])
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("invalid step %s", kv[1])
+			}
+			if len(p.Steps) > 0 && d <= p.Steps[len(p.Steps)-1].Delay {
+				return nil, fmt.Errorf("step delay must increase %s", kv[1])
+			}
+			st := escalationStepEnt{Delay: d}
+			if len(a) == 2 && a[1] != "" {
+				st.Dst = strings.Split(a[1], "|")
+			}
+			p.Steps = append(p.Steps, st)
+		default:
+			return nil, fmt.Errorf("unknown field %s", kv[0])
+		}
+	}
+	if len(p.Steps) < 1 {
+		return nil, fmt.Errorf("no step")
+	}
+	return p, nil
+}
+
+func (p *escalationPolicyEnt) match(n *datastore.NotifyEnt, routes []string) bool {
+	if len(p.Levels) > 0 && !matchRouteLevel(p.Levels, n.Level) {
+		return false
+	}
+	if len(p.IDs) > 0 && !matchRoutePattern(p.IDs, n.ID) {
+		return false
+	}
+	if len(p.Routes) > 0 {
+		for _, r := range routes {
+			if matchRoutePattern(p.Routes, r) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func findEscalationPolicy(policies []*escalationPolicyEnt, name string) *escalationPolicyEnt {
+	for _, p := range policies {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// startEscalation saves pending escalation of notify sent to destinations.
+func startEscalation(policies []*escalationPolicyEnt, n *datastore.NotifyEnt, dst []*notifyDstEnt, routes []string, now time.Time) {
+	if len(dst) < 1 || n.Escalation > 0 {
+		return
+	}
+	var p *escalationPolicyEnt
+	for _, e := range policies {
+		if e.match(n, routes) {
+			p = e
+			break
+		}
+	}
+	if p == nil {
+		return
+	}
+	target := datastore.GetNotifyWorkflowTarget(n)
+	if datastore.GetEscalation(target) != nil {
+		// Incident is already escalating
+		return
+	}
+	if datastore.GetNotifyWorkflow(target).State != "new" {
+		return
+	}
+	e := &datastore.EscalationEnt{
+		Target:  target,
+		Policy:  p.Name,
+		Notify:  *n,
+		Created: now.UnixNano(),
+		Next:    now.Add(p.Steps[0].Delay).UnixNano(),
+	}
+	e.Notify.Delivery = nil
+	for _, d := range dst {
+		e.Dst = append(e.Dst, d.ID)
+	}
+	datastore.SaveEscalation(e)
+}
+
+// checkEscalations sends escalation steps of unacknowledged notify.
+func checkEscalations(policies []*escalationPolicyEnt, ob *outboxEnt, all []*notifyDstEnt, now time.Time) {
+	list := []*datastore.EscalationEnt{}
+	datastore.ForEachEscalation(func(e *datastore.EscalationEnt) bool {
+		list = append(list, e)
+		return true
+	})
+	for _, e := range list {
+		p := findEscalationPolicy(policies, e.Policy)
+		if p == nil || e.Step >= len(p.Steps) {
+			datastore.DeleteEscalation(e.Target)
+			continue
+		}
+		if s := datastore.GetNotifyWorkflow(e.Target).State; s != "new" {
+			log.Printf("notify escalation %s stop state=%s", e.Target, s)
+			datastore.DeleteEscalation(e.Target)
+			continue
+		}
+		if now.UnixNano() < e.Next {
+			continue
+		}
+		st := p.Steps[e.Step]
+		names := st.Dst
+		if len(names) < 1 {
+			names = e.Dst
+		}
+		n := e.Notify
+		n.Escalation = e.Step + 1
+		for _, d := range selectNotifyDst(names, all) {
+			ob.deliver(d, &n, now)
+		}
+		log.Printf("notify escalation %s policy=%s step=%d dst=%s", e.Target, p.Name, n.Escalation, strings.Join(names, ","))
+		e.Step++
+		if e.Step >= len(p.Steps) {
+			datastore.DeleteEscalation(e.Target)
+			continue
+		}
+		e.Next = e.Created + int64(p.Steps[e.Step].Delay)
+		datastore.SaveEscalation(e)
+	}
+}
@@ -0,0 +1,128 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func TestParseEscalationPolicy(t *testing.T) {
+	p, err := parseEscalationPolicy("name=oncall level=critical|high+ id=rule* route=soc step=15m step=30m/mail:1|webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "oncall" || len(p.Steps) != 2 || p.Steps[0].Delay != time.Minute*15 || len(p.Steps[0].Dst) != 0 ||
+		len(p.Steps[1].Dst) != 2 || p.Steps[1].Dst[1] != "webhook" {
+		t.Errorf("invalid policy %+v", p)
+	}
+	for _, s := range []string{
+		"level=critical",
+		"step=0",
+		"step=30m step=15m",
+		"level=urgent step=15m",
+		"unknown=x step=15m",
+	} {
+		if _, err := parseEscalationPolicy(s); err == nil {
+			t.Errorf("no error %s", s)
+		}
+	}
+}
+
+func TestEscalation(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NotifyRetention = 1
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	sent := []string{}
+	all := []*notifyDstEnt{}
+	for _, kind := range []string{"webhook", "mail"} {
+		name := kind + ":1"
+		all = addNotifyDst(all, kind, 0, kind+" dst", func(n *datastore.NotifyEnt) error {
+			if n.Escalation > 0 {
+				sent = append(sent, name)
+			}
+			return nil
+		})
+	}
+	ob := newOutbox(all)
+	policies := getEscalationPolicies([]string{
+		"name=soc route=soc step=1h",
+		"level=high+ step=15m step=30m/mail",
+	})
+	if policies[1].Name != "escalation2" {
+		t.Errorf("invalid default name %s", policies[1].Name)
+	}
+	now := time.Now()
+	n := getTestNotify()
+	n.Time = now.UnixNano()
+	datastore.SaveNotify(n)
+	target := datastore.GetNotifyWorkflowTarget(n)
+	// Not sent
+	startEscalation(policies, n, nil, nil, now)
+	if datastore.GetEscalation(target) != nil {
+		t.Fatal("escalation of not sent notify")
+	}
+	ob.deliver(all[0], n, now)
+	startEscalation(policies, n, all[:1], []string{"default"}, now)
+	e := datastore.GetEscalation(target)
+	if e == nil || e.Policy != "escalation2" || len(e.Dst) != 1 || e.Dst[0] != all[0].ID {
+		t.Fatalf("invalid escalation %+v", e)
+	}
+	checkEscalations(policies, ob, all, now.Add(time.Minute*10))
+	if len(sent) != 0 {
+		t.Errorf("escalation before delay %v", sent)
+	}
+	checkEscalations(policies, ob, all, now.Add(time.Minute*15))
+	if len(sent) != 1 || sent[0] != "webhook:1" {
+		t.Errorf("invalid first step %v", sent)
+	}
+	checkEscalations(policies, ob, all, now.Add(time.Minute*30))
+	if len(sent) != 2 || sent[1] != "mail:1" {
+		t.Errorf("invalid second step %v", sent)
+	}
+	if datastore.GetEscalation(target) != nil {
+		t.Error("escalation not deleted after last step")
+	}
+	// Escalation deliveries do not overwrite first delivery
+	found := false
+	datastore.ForEachNotify(n.Time, n.Time+1, func(sn *datastore.NotifyEnt) bool {
+		found = true
+		if len(sn.Delivery) != 3 {
+			t.Errorf("invalid delivery %+v", sn.Delivery)
+			return false
+		}
+		for i, d := range sn.Delivery {
+			if d.Escalation != i || d.Status != "sent" || d.Dst != []*notifyDstEnt{all[0], all[0], all[1]}[i].ID {
+				t.Errorf("invalid delivery %d %+v", i, d)
+			}
+		}
+		return false
+	})
+	if !found {
+		t.Error("notify not found")
+	}
+	// Acknowledged
+	sent = []string{}
+	n.Time++
+	datastore.SaveNotify(n)
+	target = datastore.GetNotifyWorkflowTarget(n)
+	startEscalation(policies, n, all, []string{"soc"}, now)
+	if e := datastore.GetEscalation(target); e == nil || e.Policy != "soc" {
+		t.Fatalf("invalid escalation %+v", e)
+	}
+	if _, err := datastore.UpdateNotifyWorkflow(target, "user1", "acknowledged", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	checkEscalations(policies, ob, all, now.Add(time.Hour*2))
+	if len(sent) != 0 || datastore.GetEscalation(target) != nil {
+		t.Errorf("escalation not stopped %v", sent)
+	}
+	// Policy not matched
+	n.Level = "low"
+	n.Time++
+	startEscalation(policies, n, all, nil, now)
+	if datastore.GetEscalation(datastore.GetNotifyWorkflowTarget(n)) != nil {
+		t.Error("escalation of unmatched notify")
+	}
+}
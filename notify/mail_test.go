@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+type testMailEnt struct {
+	Auth string
+	From string
+	To   []string
+	Data string
+}
+
+// startTestSMTPServer starts minimal SMTP server for test.
+func startTestSMTPServer(t *testing.T, ch chan *testMailEnt) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go handleTestSMTP(conn, ch)
+		}
+	}()
+	return l.Addr().String()
+}
+
+func handleTestSMTP(conn net.Conn, ch chan *testMailEnt) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	m := &testMailEnt{}
+	fmt.Fprintf(conn, "220 localhost ESMTP test\r\n")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			fmt.Fprintf(conn, "250-localhost\r\n250-AUTH PLAIN LOGIN\r\n250 8BITMIME\r\n")
+		case strings.HasPrefix(cmd, "AUTH PLAIN"):
+			b, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			m.Auth = "plain:" + strings.ReplaceAll(string(b), "\x00", ":")
+			fmt.Fprintf(conn, "235 ok\r\n")
+		case strings.HasPrefix(cmd, "AUTH LOGIN"):
+			fmt.Fprintf(conn, "334 %s\r\n", base64.StdEncoding.EncodeToString([]byte("Username:")))
+			u, _ := r.ReadString('\n')
+			fmt.Fprintf(conn, "334 %s\r\n", base64.StdEncoding.EncodeToString([]byte("Password:")))
+			p, _ := r.ReadString('\n')
+			ub, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(u))
+			pb, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(p))
+			m.Auth = "login:" + string(ub) + ":" + string(pb)
+			fmt.Fprintf(conn, "235 ok\r\n")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			m.From = strings.Trim(strings.Fields(line[len("MAIL FROM:"):])[0], "<>")
+			fmt.Fprintf(conn, "250 ok\r\n")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			m.To = append(m.To, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			fmt.Fprintf(conn, "250 ok\r\n")
+		case cmd == "DATA":
+			fmt.Fprintf(conn, "354 go ahead\r\n")
+			data := new(strings.Builder)
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			m.Data = data.String()
+			fmt.Fprintf(conn, "250 ok\r\n")
+		case cmd == "QUIT":
+			fmt.Fprintf(conn, "221 bye\r\n")
+			ch <- m
+			return
+		default:
+			fmt.Fprintf(conn, "250 ok\r\n")
+		}
+	}
+}
+
+func decodeTestMail(s string) string {
+	b, _ := io.ReadAll(quotedprintable.NewReader(strings.NewReader(s)))
+	return string(b)
+}
+
+func TestMailDst(t *testing.T) {
+	ch := make(chan *testMailEnt, 2)
+	addr := startTestSMTPServer(t, ch)
+	SetSigmaRuleMetaFunc(func(id string) *SigmaRuleMeta {
+		if id != "rule1" {
+			return nil
+		}
+		return &SigmaRuleMeta{
+			Description:    "Detects failed login",
+			References:     []string{"https://example.com/ref"},
+			FalsePositives: []string{"typo"},
+		}
+	})
+	defer SetSigmaRuleMetaFunc(nil)
+	d := getMailDst(fmt.Sprintf("smtp://user:pass@%s/?from=eye@example.com&to=a@example.com&to=b@example.com&auth=login&tls=none", addr))
+	if d == nil {
+		t.Fatal("invalid mail dst")
+	}
+	d.add(getTestNotify())
+	var m *testMailEnt
+	select {
+	case m = <-ch:
+	case <-time.After(time.Second * 5):
+		t.Fatal("mail not received")
+	}
+	if m.Auth != "login:user:pass" || m.From != "eye@example.com" || len(m.To) != 2 || m.To[1] != "b@example.com" {
+		t.Errorf("invalid mail %+v", m)
+	}
+	body := decodeTestMail(m.Data)
+	for _, s := range []string{"multipart/alternative", "text/plain", "text/html", "Detects failed login", "https://example.com/ref", "Login &#34;failed&#34;"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("mail body does not contain %s", s)
+		}
+	}
+
+	// Digest
+	d = getMailDst(fmt.Sprintf("smtp://user:pass@%s/?to=a@example.com&digest=5&tls=none", addr))
+	list := []*datastore.NotifyEnt{}
+	for i := 0; i < 3; i++ {
+		n := getTestNotify()
+		if i == 2 {
+			n.ID = "rule2"
+			n.Title = "Other"
+			n.Level = "low"
+		}
+		list = append(list, n)
+	}
+	if err := d.sendDigest(list); err != nil {
+		t.Fatalf("send digest err=%v", err)
+	}
+	select {
+	case m = <-ch:
+	case <-time.After(time.Second * 5):
+		t.Fatal("digest not received")
+	}
+	if m.Auth != "plain::user:pass" {
+		t.Errorf("invalid auth %s", m.Auth)
+	}
+	body = decodeTestMail(m.Data)
+	for _, s := range []string{"digest 3 notifications", "high: 2", "low: 1", "rule1 Login \"failed\": 2", "rule2 Other: 1"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("digest body does not contain %s\n%s", s, body)
+		}
+	}
+
+	// AUTH without TLS is refused unless tls=none
+	d = getMailDst(fmt.Sprintf("smtp://user:pass@%s/?to=a@example.com", addr))
+	if err := d.send("test", "text", "html"); err == nil || !strings.Contains(err.Error(), "tls=none") {
+		t.Errorf("AUTH without TLS must be refused err=%v", err)
+	}
+	select {
+	case m = <-ch:
+		t.Errorf("mail sent without TLS %+v", m)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestMailDigestFlush(t *testing.T) {
+	ch := make(chan *testMailEnt, 10)
+	addr := startTestSMTPServer(t, ch)
+	datastore.Config.DBPath = ""
+	datastore.Config.NotifyRetention = 1
+	datastore.Config.MailDst = []string{fmt.Sprintf("smtp://%s/?to=a@example.com&digest=60&tls=none", addr)}
+	datastore.OpenDB()
+	defer func() {
+		datastore.CloseDB()
+		datastore.Config.MailDst = []string{}
+	}()
+	Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go Start(ctx, wg)
+	Norify(getTestNotify())
+	time.Sleep(time.Millisecond * 200)
+	cancel()
+	wg.Wait()
+	select {
+	case m := <-ch:
+		if body := decodeTestMail(m.Data); !strings.Contains(body, "digest 1 notifications") {
+			t.Errorf("invalid digest mail\n%s", body)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("pending digest not sent on stop")
+	}
+}
@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	server "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+type testMqttMessage struct {
+	Topic   string
+	Payload []byte
+}
+
+func startTestMqttBroker(t *testing.T, addr string, ch chan *testMqttMessage) *server.Server {
+	s := server.New(&server.Options{InlineClient: true})
+	if err := s.AddHook(new(auth.AllowHook), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddListener(listeners.NewTCP(listeners.Config{ID: "tcp", Address: addr})); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Subscribe("twlogeye/#", 1, func(cl *server.Client, sub packets.Subscription, pk packets.Packet) {
+		ch <- &testMqttMessage{Topic: pk.TopicName, Payload: pk.Payload}
+	}); err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve()
+	return s
+}
+
+func getTestMqttAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestMqttDst(t *testing.T) {
+	mqttRetryInterval = time.Millisecond * 200
+	addr := getTestMqttAddr(t)
+	// Broker is not running yet. Error is returned to retry by outbox.
+	d := getMqttDst(fmt.Sprintf("mqtt://user:pass@%s/twlogeye/{level}/{src}?qos=1", addr))
+	if d == nil {
+		t.Fatal("invalid mqtt dst")
+	}
+	defer d.close()
+	if d.QoS != 1 || d.Retain || d.Topic != "twlogeye/{level}/{src}" {
+		t.Errorf("invalid mqtt dst %+v", d)
+	}
+	n := &datastore.NotifyEnt{
+		Time:  time.Now().UnixNano(),
+		Type:  datastore.Syslog,
+		Src:   "192.168.1.1",
+		ID:    "rule1",
+		Level: "high",
+		Title: "test1",
+	}
+	if err := d.publish(n); err == nil {
+		t.Fatal("publish without broker must be error")
+	}
+
+	ch := make(chan *testMqttMessage, 10)
+	s := startTestMqttBroker(t, addr, ch)
+	defer s.Close()
+	// Retry after reconnect
+	for i := 0; !d.client.IsConnectionOpen(); i++ {
+		if i > 50 {
+			t.Fatal("mqtt not connected")
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	if err := d.publish(n); err != nil {
+		t.Fatalf("publish err=%v", err)
+	}
+	select {
+	case m := <-ch:
+		if m.Topic != "twlogeye/high/192.168.1.1" {
+			t.Errorf("invalid topic %s", m.Topic)
+		}
+		var w webHookNotifyEnt
+		if err := json.Unmarshal(m.Payload, &w); err != nil || w.ID != "rule1" || w.Title != "test1" {
+			t.Errorf("invalid payload %s", m.Payload)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("retried message not received")
+	}
+	if err := d.publish(&datastore.NotifyEnt{
+		Time:  time.Now().UnixNano(),
+		Type:  datastore.AnomalyReport,
+		Src:   "anomaly:syslog",
+		ID:    "TwLogEye:anomaly",
+		Level: "medium",
+	}); err != nil {
+		t.Errorf("publish err=%v", err)
+	}
+	select {
+	case m := <-ch:
+		if m.Topic != "twlogeye/medium/anomaly:syslog" {
+			t.Errorf("invalid topic %s", m.Topic)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("message not received")
+	}
+}
+
+func TestMqttTopic(t *testing.T) {
+	n := &datastore.NotifyEnt{
+		Src:   "a/b+c#",
+		Level: "low",
+		ID:    "id1",
+		Type:  datastore.Syslog,
+	}
+	if s := getMqttTopic("x/{type}/{id}/{src}/{level}/{tags}", n); s != "x/syslog/id1/a_b_c_/low/unknown" {
+		t.Errorf("invalid topic %s", s)
+	}
+}
@@ -3,11 +3,15 @@ package notify
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
-	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -26,26 +30,87 @@ func Init() {
 
 func Start(ctx context.Context, wg *sync.WaitGroup) {
 	log.Println("start notify")
-	syslogDst := []net.Conn{}
-	trapDst := []*gosnmp.GoSNMP{}
-	for _, d := range datastore.Config.SyslogDst {
-		log.Printf("syslog dst %s", d)
-		syslogDst = append(syslogDst, getSyslogDst(d))
-	}
+	syslogDst := []*syslogDstEnt{}
+	trapDst := []*trapDstEnt{}
+	webhookDst := []*webhookDstEnt{}
+	dstList := []*notifyDstEnt{}
 	host, err := os.Hostname()
 	if err != nil {
 		host = "localhost"
 	}
-	for _, d := range datastore.Config.TrapDst {
+	for i, d := range datastore.Config.SyslogDst {
+		if s := getSyslogDst(d); s != nil {
+			syslogDst = append(syslogDst, s)
+			dstList = addNotifyDst(dstList, "syslog", i, d, func(n *datastore.NotifyEnt) error { return sendSyslog(s, host, n) })
+		}
+	}
+	for i, d := range datastore.Config.TrapDst {
 		log.Printf("trap dst %s", d)
-		trapDst = append(trapDst, getTrapDst(d))
+		t := getTrapDst(d)
+		trapDst = append(trapDst, t)
+		dstList = addNotifyDst(dstList, "trap", i, d, func(n *datastore.NotifyEnt) error { return sendTrap(t, n) })
+	}
+	for i, d := range datastore.Config.WebhookDst {
+		if w := getWebhookDst(d); w != nil {
+			webhookDst = append(webhookDst, w)
+			dstList = addNotifyDst(dstList, "webhook", i, d, func(n *datastore.NotifyEnt) error { return sendWebhook(w, n) })
+		}
+	}
+	mqttDst := []*mqttDstEnt{}
+	for i, d := range datastore.Config.MqttDst {
+		if m := getMqttDst(d); m != nil {
+			log.Printf("mqtt dst %s", m.Broker)
+			mqttDst = append(mqttDst, m)
+			dstList = addNotifyDst(dstList, "mqtt", i, d, m.publish)
+		}
 	}
+	for i, d := range datastore.Config.MailDst {
+		if m := getMailDst(d); m != nil {
+			log.Printf("mail dst %s to=%s digest=%d", m.Host, strings.Join(m.To, ";"), m.Digest)
+			dstList = addNotifyDst(dstList, "mail", i, d, m.add)
+			if m.Digest > 0 {
+				dstList[len(dstList)-1].digest = time.Duration(m.Digest) * time.Minute
+				dstList[len(dstList)-1].sendDigest = m.sendDigest
+			}
+		}
+	}
+	for _, d := range dstList {
+		log.Printf("notify dst %s id=%s", d.Name, d.ID)
+	}
+	routes := getNotifyRoutes(datastore.Config.NotifyRoute)
+	for _, r := range routes {
+		names := []string{}
+		for _, d := range r.selectDst(dstList) {
+			names = append(names, d.Name)
+		}
+		log.Printf("notify route %s dst=%s", r.Name, strings.Join(names, ","))
+	}
+	actions := getNotifyActions(datastore.Config.NotifyAction)
+	for _, r := range routes {
+		for _, a := range r.Actions {
+			if findNotifyAction(actions, a) == nil {
+				log.Fatalf("notify route %s unknown action %s", r.Name, a)
+			}
+		}
+	}
+	actionWg := &sync.WaitGroup{}
+	escalations := getEscalationPolicies(datastore.Config.NotifyEscalation)
+	for _, p := range escalations {
+		log.Printf("notify escalation %s steps=%d", p.Name, len(p.Steps))
+	}
+	ob := newOutbox(dstList)
+	timer := time.NewTicker(time.Second * 10)
 	defer func() {
+		timer.Stop()
+		actionWg.Wait()
 		for _, d := range syslogDst {
-			d.Close()
+			d.close()
+		}
+		for _, d := range mqttDst {
+			d.close()
 		}
 		for _, d := range trapDst {
-			d.Conn.Close()
+			d.SNMP.Conn.Close()
 		}
 		wg.Done()
 	}()
@@ -53,17 +118,26 @@ func Start(ctx context.Context, wg *sync.WaitGroup) {
 		select {
 		case <-ctx.Done():
 			log.Println("stop notify")
+			// Send pending digest before stop
+			ob.flushDigest(time.Now())
 			return
-		case n := <-notifyCh:
-			s := fmt.Sprintf("<%d>%s %s twlogeye: src=%s,id=%s,tags=%s,title=%s",
-				getSyslogLevel(n.Level), time.Now().Format("2006-01-02T15:04:05-07:00"), host, n.Src, n.ID, n.Tags, n.Title)
-			for _, d := range syslogDst {
-				d.Write([]byte(s))
+		case <-timer.C:
+			now := time.Now()
+			for _, r := range routes {
+				r.cleanup(now)
 			}
-			for _, d := range trapDst {
-				sendTrap(d, n)
+			checkEscalations(escalations, ob, dstList, now)
+			ob.retry(now, false)
+		case <-retryCh:
+			ob.retry(time.Now(), true)
+		case n := <-notifyCh:
+			now := time.Now()
+			dst, names, acts := selectNotifyRoute(routes, dstList, n, now)
+			for _, d := range dst {
+				ob.deliver(d, n, now)
 			}
-			webhook(n)
+			runNotifyActions(actions, acts, n, actionWg)
+			startEscalation(escalations, n, dst, names, now)
 		}
 	}
 }
@@ -72,34 +146,40 @@ func Norify(n *datastore.NotifyEnt) {
 	notifyCh <- n
 }
 
-func getSyslogLevel(l string) int {
-	switch l {
-	case "low":
-		return 19*8 + 5 // notice
-	case "medium":
-		return 19*8 + 4 // warning
-	case "high":
-		return 19*8 + 3 // error
-	case "critical":
-		return 19*8 + 2 // critical
-	//	case "informational", "info":
-	default:
-		return 19*8 + 6
-	}
+type trapDstEnt struct {
+	SNMP     *gosnmp.GoSNMP
+	Template *notifyTemplateEnt
+}
+
+// webhookDstEnt is webhook destination.
+// Options are space separated "key=value" after URL like
+// https://example.com/hook secret=xxx bearer=token header=X-Key:abc timeout=5s
+type webhookDstEnt struct {
+	URL      string
+	Template *notifyTemplateEnt
+	// HMAC-SHA256 signature key
+	Secret  string
+	Headers map[string]string
+	Client  *http.Client
 }
 
-func getSyslogDst(d string) net.Conn {
-	if !strings.Contains(d, ":") {
-		d += ":514"
+const webhookSignatureHeader = "X-Twlogeye-Signature"
+const webhookTimestampHeader = "X-Twlogeye-Timestamp"
+
+func getNotifyTemplateOrDie(t string) *notifyTemplateEnt {
+	if t == "" {
+		return nil
 	}
-	s, err := net.Dial("udp", d)
+	tmpl, err := getNotifyTemplate(t)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("notify template %s err=%v", t, err)
 	}
-	return s
+	return tmpl
 }
 
-func getTrapDst(d string) *gosnmp.GoSNMP {
+func getTrapDst(d string) *trapDstEnt {
+	t, d := splitNotifyDst(d)
+	tmpl := getNotifyTemplateOrDie(t)
 	port := 162
 	a := strings.SplitN(d, ":", 2)
 	if len(a) > 1 {
@@ -119,10 +199,19 @@ func getTrapDst(d string) *gosnmp.GoSNMP {
 	if err := dst.Connect(); err != nil {
 		log.Fatalln(err)
 	}
-	return dst
+	return &trapDstEnt{SNMP: dst, Template: tmpl}
 }
 
-func sendTrap(dst *gosnmp.GoSNMP, n *datastore.NotifyEnt) {
+func sendTrap(dst *trapDstEnt, n *datastore.NotifyEnt) error {
+	title := n.Title
+	if dst.Template != nil {
+		// Template replaces title varbind
+		if b, err := dst.Template.render(n); err == nil {
+			title = string(b)
+		} else {
+			log.Printf("trap template err=%v", err)
+		}
+	}
 	vbs := []gosnmp.SnmpPDU{
 		// TRAP OID
 		{
@@ -148,16 +237,14 @@ func sendTrap(dst *gosnmp.GoSNMP, n *datastore.NotifyEnt) {
 		}, {
 			Name:  "..1.3.6.1.4.1.17861.1.11.1.5.0",
 			Type:  gosnmp.OctetString,
-			Value: n.Title,
+			Value: title,
 		},
 	}
 	trap := gosnmp.SnmpTrap{
 		Variables: vbs,
 	}
-	_, err := dst.SendTrap(trap)
-	if err != nil {
-		log.Println("send trap err=", err)
-	}
+	_, err := dst.SNMP.SendTrap(trap)
+	return err
 }
 
 type webHookNotifyEnt struct {
@@ -171,49 +258,176 @@ type webHookNotifyEnt struct {
 	Title string `json:"Title"`
 	Tags  string `json:"Tags"`
 	Level string `json:"Level"`
+	// Incident
+	IncidentID string `json:"IncidentID,omitempty"`
+	Count      int    `json:"Count,omitempty"`
+	FirstSeen  string `json:"FirstSeen,omitempty"`
+	LastSeen   string `json:"LastSeen,omitempty"`
+	// Escalation step
+	Escalation int `json:"Escalation,omitempty"`
 }
 
-func webhook(n *datastore.NotifyEnt) {
-	if len(datastore.Config.WebhookDst) < 1 {
-		return
-	}
-	j, err := json.Marshal(&webHookNotifyEnt{
-		Time:  time.Unix(0, n.Time).Format(time.RFC3339),
-		Type:  n.Type.String(),
-		Log:   n.Log,
-		Src:   n.Src,
-		ID:    n.ID,
-		Title: n.Title,
-		Tags:  n.Tags,
-		Level: n.Level,
-	})
-	if err != nil {
-		log.Printf("webhook err=%v", err)
-		return
+// makeWebhookPayload makes JSON payload of webhook and MQTT.
+func makeWebhookPayload(n *datastore.NotifyEnt) ([]byte, error) {
+	w := &webHookNotifyEnt{
+		Time:       time.Unix(0, n.Time).Format(time.RFC3339),
+		Type:       n.Type.String(),
+		Log:        n.Log,
+		Src:        n.Src,
+		ID:         n.ID,
+		Title:      n.Title,
+		Tags:       n.Tags,
+		Level:      n.Level,
+		IncidentID: n.IncidentID,
+		Count:      n.Count,
+		Escalation: n.Escalation,
 	}
-	for _, url := range datastore.Config.WebhookDst {
-		if err := postWebhook(url, j); err != nil {
-			log.Printf("webhook err=%v", err)
+	if n.FirstSeen > 0 {
+		w.FirstSeen = time.Unix(0, n.FirstSeen).Format(time.RFC3339)
+		w.LastSeen = time.Unix(0, n.LastSeen).Format(time.RFC3339)
+	}
+	return json.Marshal(w)
+}
+
+func getWebhookDst(d string) *webhookDstEnt {
+	t, d := splitNotifyDst(d)
+	tmpl := getNotifyTemplateOrDie(t)
+	f := strings.Fields(d)
+	if len(f) < 1 {
+		log.Printf("invalid webhook dst %s", d)
+		return nil
+	}
+	e := &webhookDstEnt{
+		URL:      f[0],
+		Template: tmpl,
+		Headers:  make(map[string]string),
+	}
+	if err := e.setOptions(f[1:]); err != nil {
+		log.Printf("invalid webhook dst %s err=%v", e.URL, err)
+		return nil
+	}
+	if tmpl != nil {
+		log.Printf("webhook dst %s template=%s", e.URL, tmpl.Name)
+	}
+	return e
+}
+
+// setOptions sets options of webhook.
+// secret, bearer, basic(user:password), header(name:value), timeout, ca, cert, key and insecure.
+func (e *webhookDstEnt) setOptions(opts []string) error {
+	timeout := time.Second * 2
+	q := url.Values{}
+	for _, o := range opts {
+		kv := strings.SplitN(o, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return fmt.Errorf("invalid option %s", o)
+		}
+		switch kv[0] {
+		case "secret":
+			e.Secret = kv[1]
+		case "bearer":
+			e.Headers["Authorization"] = "Bearer " + kv[1]
+		case "basic":
+			e.Headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(kv[1]))
+		case "header":
+			h := strings.SplitN(kv[1], ":", 2)
+			if len(h) != 2 || h[0] == "" {
+				return fmt.Errorf("invalid header %s", kv[1])
+			}
+			e.Headers[h[0]] = h[1]
+		case "timeout":
+			d, err := time.ParseDuration(kv[1])
+			if err != nil {
+				// Seconds
+				s, err := strconv.Atoi(kv[1])
+				if err != nil {
+					return fmt.Errorf("invalid timeout %s", kv[1])
+				}
+				d = time.Second * time.Duration(s)
+			}
+			if d <= 0 {
+				return fmt.Errorf("invalid timeout %s", kv[1])
+			}
+			timeout = d
+		case "ca", "cert", "key", "insecure":
+			q.Set(kv[0], kv[1])
+		default:
+			return fmt.Errorf("unknown option %s", kv[0])
+		}
+	}
+	e.Client = &http.Client{Timeout: timeout}
+	if len(q) > 0 {
+		tlsConfig, err := getNotifyTLSConfig(q)
+		if err != nil {
+			return err
+		}
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.TLSClientConfig = tlsConfig
+		e.Client.Transport = tr
+	}
+	return nil
+}
+
+// signWebhook returns HMAC-SHA256 signature of "<timestamp>.<body>".
+func signWebhook(secret, ts string, body []byte) string {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(ts))
+	m.Write([]byte("."))
+	m.Write(body)
+	return "sha256=" + hex.EncodeToString(m.Sum(nil))
+}
+
+func sendWebhook(d *webhookDstEnt, n *datastore.NotifyEnt) error {
+	_, err := d.request("POST", d.URL, n)
+	return err
+}
+
+// request sends notify with method to url and returns HTTP status code.
+func (d *webhookDstEnt) request(method, url string, n *datastore.NotifyEnt) (int, error) {
+	contentType := "application/json"
+	headers := map[string]string{}
+	var body []byte
+	var err error
+	if d.Template != nil {
+		if body, err = d.Template.render(n); err != nil {
+			return 0, err
 		}
+		contentType = d.Template.ContentType
+		headers = d.Template.renderHeaders(n)
+	} else if body, err = makeWebhookPayload(n); err != nil {
+		return 0, err
+	}
+	for k, v := range d.Headers {
+		headers[k] = v
 	}
+	if d.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		headers[webhookTimestampHeader] = ts
+		headers[webhookSignatureHeader] = signWebhook(d.Secret, ts, body)
+	}
+	client := d.Client
+	if client == nil {
+		client = &http.Client{Timeout: time.Second * 2}
+	}
+	return postWebhook(client, method, url, contentType, headers, body)
 }
 
-func postWebhook(url string, j []byte) error {
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(j))
+func postWebhook(client *http.Client, method, url, contentType string, headers map[string]string, j []byte) (int, error) {
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(j))
 	if err != nil {
-		return err
+		return 0, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: time.Second * time.Duration(2),
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range headers {
+		req.Header.Set(k, v)
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode >= 300 {
-		return fmt.Errorf("webhook error %s", resp.Status)
+		return resp.StatusCode, fmt.Errorf("webhook error %s", resp.Status)
 	}
-	return nil
+	return resp.StatusCode, nil
 }
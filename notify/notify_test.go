@@ -0,0 +1,80 @@
+package notify
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWebhookOptions(t *testing.T) {
+	ch := make(chan *http.Request, 1)
+	bodyCh := make(chan []byte, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		ch <- r
+		bodyCh <- b
+	}))
+	defer ts.Close()
+	d := getWebhookDst(ts.URL + " secret=abc bearer=token header=X-Api-Key:key1 timeout=5s")
+	if d == nil || d.Client.Timeout != time.Second*5 {
+		t.Fatalf("invalid webhook dst %+v", d)
+	}
+	if err := sendWebhook(d, getTestNotify()); err != nil {
+		t.Fatal(err)
+	}
+	r := <-ch
+	b := <-bodyCh
+	if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Api-Key") != "key1" {
+		t.Errorf("invalid headers %v", r.Header)
+	}
+	tm := r.Header.Get(webhookTimestampHeader)
+	if s, err := strconv.ParseInt(tm, 10, 64); err != nil || time.Since(time.Unix(s, 0)) > time.Minute {
+		t.Errorf("invalid timestamp %s", tm)
+	}
+	if r.Header.Get(webhookSignatureHeader) != signWebhook("abc", tm, b) {
+		t.Errorf("invalid signature %s", r.Header.Get(webhookSignatureHeader))
+	}
+	// Same as: printf '1700000000.{"ID":"rule1"}' | openssl dgst -sha256 -hmac abc
+	if s := signWebhook("abc", "1700000000", []byte(`{"ID":"rule1"}`)); s != "sha256=d8ce1dbe1d6338b3cbc19558a94fd7f6b6fb894424b32f7bcaa1079b343cd54f" {
+		t.Errorf("invalid signature %s", s)
+	}
+	d = getWebhookDst("discord=" + ts.URL + " basic=user:pass timeout=3")
+	if d == nil || d.Template == nil || d.Client.Timeout != time.Second*3 {
+		t.Fatalf("invalid webhook dst %+v", d)
+	}
+	if err := sendWebhook(d, getTestNotify()); err != nil {
+		t.Fatal(err)
+	}
+	r = <-ch
+	<-bodyCh
+	if u, p, ok := r.BasicAuth(); !ok || u != "user" || p != "pass" {
+		t.Errorf("invalid basic auth %v", r.Header)
+	}
+	if r.Header.Get(webhookSignatureHeader) != "" {
+		t.Error("signature without secret")
+	}
+	for _, s := range []string{
+		ts.URL + " timeout=x",
+		ts.URL + " header=abc",
+		ts.URL + " unknown=1",
+		ts.URL + " cert=none.crt key=none.key",
+	} {
+		if getWebhookDst(s) != nil {
+			t.Errorf("no error %s", s)
+		}
+	}
+}
+
+func TestWebhookTLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	if err := sendWebhook(getWebhookDst(ts.URL), getTestNotify()); err == nil {
+		t.Error("no certificate error")
+	}
+	if err := sendWebhook(getWebhookDst(ts.URL+" insecure=true"), getTestNotify()); err != nil {
+		t.Errorf("insecure webhook err=%v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package notify
+
+import (
+	"log"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// Deliveries are persisted in outbox before sending.
+// Outbox records refer to destination by ID, not by index in config.
+// Deliveries to digest destination wait in outbox until digest interval has passed.
+// Failed deliveries are retried with exponential backoff per destination
+// and moved to dead letter list after max age.
+
+type dstRetryEnt struct {
+	Failures int
+	Next     time.Time
+	// Number of deliveries waiting in outbox
+	Queued int
+}
+
+type outboxEnt struct {
+	dstMap   map[string]*notifyDstEnt
+	retryMap map[string]*dstRetryEnt
+}
+
+var retryCh = make(chan bool, 1)
+
+func newOutbox(list []*notifyDstEnt) *outboxEnt {
+	ob := &outboxEnt{
+		dstMap:   make(map[string]*notifyDstEnt),
+		retryMap: make(map[string]*dstRetryEnt),
+	}
+	for _, d := range list {
+		ob.dstMap[d.ID] = d
+		ob.retryMap[d.ID] = &dstRetryEnt{}
+		if d.digest > 0 {
+			ob.retryMap[d.ID].Next = time.Now().Add(d.digest)
+		}
+	}
+	// Deliveries left from last run
+	datastore.ForEachOutbox(func(o *datastore.OutboxEnt) bool {
+		if r, ok := ob.retryMap[o.Dst]; ok {
+			r.Queued++
+		}
+		return true
+	})
+	return ob
+}
+
+// Retry resets backoff and retries deliveries in outbox now.
+func Retry() {
+	select {
+	case retryCh <- true:
+	default:
+	}
+}
+
+func getRetryInterval(failures int) time.Duration {
+	base := time.Second * time.Duration(max(datastore.Config.NotifyRetryInterval, 1))
+	maxInterval := time.Second * time.Duration(max(datastore.Config.NotifyRetryMaxInterval, 1))
+	d := base
+	for i := 1; i < failures && d < maxInterval; i++ {
+		d *= 2
+	}
+	return min(d, maxInterval)
+}
+
+// deliver sends notify to destination via outbox.
+func (ob *outboxEnt) deliver(d *notifyDstEnt, n *datastore.NotifyEnt, now time.Time) {
+	o := datastore.NewOutbox(n, d.ID, now.UnixNano())
+	r := ob.retryMap[d.ID]
+	if d.digest > 0 {
+		if r.Queued == 0 && r.Failures == 0 && !now.Before(r.Next) {
+			// First notification after idle waits digest interval
+			r.Next = now.Add(d.digest)
+		}
+		r.Queued++
+		o.NextTry = r.Next.UnixNano()
+		datastore.SaveOutbox(o)
+		updateDelivery(o, "digest")
+		return
+	}
+	if r.Queued > 0 || now.Before(r.Next) {
+		// Keep order while destination is in backoff
+		r.Queued++
+		o.NextTry = r.Next.UnixNano()
+		datastore.SaveOutbox(o)
+		updateDelivery(o, "retry")
+		return
+	}
+	datastore.SaveOutbox(o)
+	if !ob.send(d, o, now) {
+		r.Queued++
+	}
+}
+
+// send sends delivery and updates outbox.
+func (ob *outboxEnt) send(d *notifyDstEnt, o *datastore.OutboxEnt, now time.Time) bool {
+	r := ob.retryMap[d.ID]
+	o.Attempts++
+	o.LastTry = now.UnixNano()
+	if err := d.send(&o.Notify); err != nil {
+		r.Failures++
+		r.Next = now.Add(getRetryInterval(r.Failures))
+		o.LastError = err.Error()
+		o.NextTry = r.Next.UnixNano()
+		datastore.SaveOutbox(o)
+		updateDelivery(o, "retry")
+		log.Printf("notify dst %s id=%s err=%v retry=%s", d.Name, d.ID, err, r.Next.Format(time.RFC3339))
+		return false
+	}
+	r.Failures = 0
+	r.Next = time.Time{}
+	o.LastError = ""
+	datastore.DeleteOutbox(o.Key)
+	updateDelivery(o, "sent")
+	return true
+}
+
+// retry retries deliveries in outbox.
+func (ob *outboxEnt) retry(now time.Time, force bool) {
+	list := []*datastore.OutboxEnt{}
+	datastore.ForEachOutbox(func(o *datastore.OutboxEnt) bool {
+		list = append(list, o)
+		return true
+	})
+	if force {
+		for _, r := range ob.retryMap {
+			r.Next = time.Time{}
+		}
+	}
+	maxAge := time.Minute * time.Duration(datastore.Config.NotifyMaxAge)
+	blocked := make(map[string]bool)
+	digest := make(map[string][]*datastore.OutboxEnt)
+	for _, r := range ob.retryMap {
+		r.Queued = 0
+	}
+	for _, o := range list {
+		d, ok := ob.dstMap[o.Dst]
+		if !ok {
+			o.LastError = "unknown destination"
+			ob.deadLetter(o)
+			continue
+		}
+		r := ob.retryMap[o.Dst]
+		if maxAge > 0 && now.Sub(time.Unix(0, o.Created)) > maxAge {
+			ob.deadLetter(o)
+			continue
+		}
+		if d.digest > 0 {
+			digest[o.Dst] = append(digest[o.Dst], o)
+			r.Queued++
+			continue
+		}
+		if blocked[o.Dst] || now.Before(r.Next) || !ob.send(d, o, now) {
+			blocked[o.Dst] = true
+			r.Queued++
+		}
+	}
+	for id, list := range digest {
+		if !now.Before(ob.retryMap[id].Next) {
+			ob.sendDigest(ob.dstMap[id], list, now)
+		}
+	}
+}
+
+// flushDigest sends deliveries waiting for digest before stop.
+// Deliveries failed to send are kept in outbox for next start.
+func (ob *outboxEnt) flushDigest(now time.Time) {
+	digest := make(map[string][]*datastore.OutboxEnt)
+	datastore.ForEachOutbox(func(o *datastore.OutboxEnt) bool {
+		if d, ok := ob.dstMap[o.Dst]; ok && d.digest > 0 {
+			digest[o.Dst] = append(digest[o.Dst], o)
+		}
+		return true
+	})
+	for id, list := range digest {
+		ob.sendDigest(ob.dstMap[id], list, now)
+	}
+}
+
+// sendDigest sends deliveries as one digest and updates outbox.
+func (ob *outboxEnt) sendDigest(d *notifyDstEnt, list []*datastore.OutboxEnt, now time.Time) {
+	r := ob.retryMap[d.ID]
+	nl := []*datastore.NotifyEnt{}
+	for _, o := range list {
+		nl = append(nl, &o.Notify)
+	}
+	err := d.sendDigest(nl)
+	if err != nil {
+		r.Failures++
+		r.Next = now.Add(getRetryInterval(r.Failures))
+		log.Printf("notify dst %s id=%s digest=%d err=%v retry=%s", d.Name, d.ID, len(list), err, r.Next.Format(time.RFC3339))
+	} else {
+		r.Failures = 0
+		r.Queued = 0
+		r.Next = now.Add(d.digest)
+	}
+	for _, o := range list {
+		o.Attempts++
+		o.LastTry = now.UnixNano()
+		if err != nil {
+			o.LastError = err.Error()
+			o.NextTry = r.Next.UnixNano()
+			datastore.SaveOutbox(o)
+			updateDelivery(o, "retry")
+			continue
+		}
+		o.LastError = ""
+		datastore.DeleteOutbox(o.Key)
+		updateDelivery(o, "sent")
+	}
+}
+
+func (ob *outboxEnt) deadLetter(o *datastore.OutboxEnt) {
+	log.Printf("notify dst %s dead letter %s err=%s", o.Dst, o.Key, o.LastError)
+	datastore.MoveToDeadLetter(o)
+	updateDelivery(o, "dead")
+}
+
+func updateDelivery(o *datastore.OutboxEnt, status string) {
+	datastore.UpdateNotifyDelivery(o.Notify.Time, o.Notify.ID, &datastore.NotifyDeliveryEnt{
+		Dst:      o.Dst,
+		Status:   status,
+		Attempts: o.Attempts,
+		Time:     time.Now().UnixNano(),
+		Error:    o.LastError,
+		// Escalation is recorded separately from first delivery
+		Escalation: o.Notify.Escalation,
+	})
+}
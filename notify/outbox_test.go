@@ -0,0 +1,207 @@
+package notify
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func countTestOutbox() (int, int) {
+	c := 0
+	dead := 0
+	datastore.ForEachOutbox(func(o *datastore.OutboxEnt) bool {
+		c++
+		return true
+	})
+	datastore.ForEachDeadLetter(func(o *datastore.OutboxEnt) bool {
+		dead++
+		return true
+	})
+	return c, dead
+}
+
+func getTestDelivery(n *datastore.NotifyEnt) *datastore.NotifyDeliveryEnt {
+	var ret *datastore.NotifyDeliveryEnt
+	datastore.ForEachNotify(n.Time, n.Time, func(e *datastore.NotifyEnt) bool {
+		if e.ID == n.ID && len(e.Delivery) > 0 {
+			ret = &e.Delivery[0]
+		}
+		return true
+	})
+	return ret
+}
+
+func TestOutbox(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NotifyRetention = 1
+	datastore.Config.NotifyRetryInterval = 10
+	datastore.Config.NotifyRetryMaxInterval = 60
+	datastore.Config.NotifyMaxAge = 60
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	fail := true
+	sent := []string{}
+	send := func(n *datastore.NotifyEnt) error {
+		if fail {
+			return fmt.Errorf("server down")
+		}
+		sent = append(sent, n.ID)
+		return nil
+	}
+	other := []string{}
+	list := addNotifyDst(nil, "webhook", 0, "https://example.com/hook1", send)
+	list = addNotifyDst(list, "webhook", 1, "https://example.com/hook2", func(n *datastore.NotifyEnt) error {
+		other = append(other, n.ID)
+		return nil
+	})
+	d := list[0]
+	ob := newOutbox(list)
+	now := time.Now()
+	n1 := getTestNotify()
+	n1.Time = now.UnixNano()
+	datastore.SaveNotify(n1)
+	ob.deliver(d, n1, now)
+	if c, _ := countTestOutbox(); c != 1 {
+		t.Fatalf("failed delivery not in outbox %d", c)
+	}
+	if s := getTestDelivery(n1); s == nil || s.Status != "retry" || s.Attempts != 1 || s.Error != "server down" {
+		t.Errorf("invalid delivery status %+v", s)
+	}
+	// Queued while backoff
+	n2 := getTestNotify()
+	n2.ID = "rule2"
+	n2.Time = now.UnixNano() + 1
+	ob.deliver(d, n2, now.Add(time.Second))
+	if c, _ := countTestOutbox(); c != 2 || ob.retryMap[d.ID].Queued != 2 {
+		t.Fatalf("delivery not queued %d", c)
+	}
+	// Backoff
+	fail = false
+	ob.retry(now.Add(time.Second*5), false)
+	if len(sent) != 0 {
+		t.Errorf("retry before backoff %v", sent)
+	}
+	ob.retry(now.Add(time.Second*11), false)
+	if len(sent) != 2 || sent[0] != "rule1" || sent[1] != "rule2" {
+		t.Errorf("invalid retry order %v", sent)
+	}
+	if c, _ := countTestOutbox(); c != 0 {
+		t.Errorf("outbox not empty %d", c)
+	}
+	if s := getTestDelivery(n1); s == nil || s.Status != "sent" || s.Attempts != 2 {
+		t.Errorf("invalid delivery status %+v", s)
+	}
+	// Exponential backoff
+	if getRetryInterval(1) != time.Second*10 || getRetryInterval(3) != time.Second*40 || getRetryInterval(10) != time.Minute {
+		t.Errorf("invalid retry interval %v %v %v", getRetryInterval(1), getRetryInterval(3), getRetryInterval(10))
+	}
+	// Dead letter
+	fail = true
+	ob.deliver(d, n1, now)
+	ob.retry(now.Add(time.Hour*2), false)
+	if c, dead := countTestOutbox(); c != 0 || dead != 1 {
+		t.Fatalf("not dead letter outbox=%d dead=%d", c, dead)
+	}
+	if s := getTestDelivery(n1); s == nil || s.Status != "dead" {
+		t.Errorf("invalid delivery status %+v", s)
+	}
+	// Re-drive
+	if datastore.RedriveDeadLetter("") != 1 {
+		t.Fatal("redrive failed")
+	}
+	fail = false
+	sent = []string{}
+	ob.retry(time.Now(), true)
+	if c, dead := countTestOutbox(); c != 0 || dead != 0 || len(sent) != 1 {
+		t.Errorf("redrive not sent outbox=%d dead=%d sent=%v", c, dead, sent)
+	}
+	// Queued delivery goes to same destination after config is reordered
+	fail = true
+	sent = []string{}
+	ob.deliver(d, n2, now)
+	fail = false
+	list = addNotifyDst(nil, "webhook", 0, "https://example.com/hook2", list[1].send)
+	list = addNotifyDst(list, "webhook", 1, "https://example.com/hook1", send)
+	ob = newOutbox(list)
+	ob.retry(time.Now(), true)
+	if len(sent) != 1 || sent[0] != "rule2" || len(other) != 0 {
+		t.Errorf("invalid destination after reorder sent=%v other=%v", sent, other)
+	}
+}
+
+func TestOutboxDigest(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NotifyRetention = 1
+	datastore.Config.NotifyRetryInterval = 10
+	datastore.Config.NotifyRetryMaxInterval = 60
+	datastore.Config.NotifyMaxAge = 60
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	fail := true
+	sent := [][]string{}
+	list := addNotifyDst(nil, "mail", 0, "smtp://localhost/?to=a@example.com&digest=5", nil)
+	d := list[0]
+	d.digest = time.Minute * 5
+	d.sendDigest = func(nl []*datastore.NotifyEnt) error {
+		if fail {
+			return fmt.Errorf("server down")
+		}
+		ids := []string{}
+		for _, n := range nl {
+			ids = append(ids, n.ID)
+		}
+		sent = append(sent, ids)
+		return nil
+	}
+	ob := newOutbox(list)
+	now := time.Now()
+	n1 := getTestNotify()
+	n1.Time = now.UnixNano()
+	datastore.SaveNotify(n1)
+	ob.deliver(d, n1, now)
+	n2 := getTestNotify()
+	n2.ID = "rule2"
+	n2.Time = now.UnixNano() + 1
+	ob.deliver(d, n2, now.Add(time.Minute))
+	if s := getTestDelivery(n1); s == nil || s.Status != "digest" || s.Attempts != 0 {
+		t.Errorf("invalid delivery status %+v", s)
+	}
+	ob.retry(now.Add(time.Minute*4), false)
+	if c, _ := countTestOutbox(); c != 2 {
+		t.Fatalf("digest sent before interval outbox=%d", c)
+	}
+	// Failed digest is kept in outbox
+	ob.retry(now.Add(time.Minute*6), false)
+	if c, _ := countTestOutbox(); c != 2 {
+		t.Fatalf("failed digest not in outbox %d", c)
+	}
+	if s := getTestDelivery(n1); s == nil || s.Status != "retry" || s.Attempts != 1 || s.Error != "server down" {
+		t.Errorf("invalid delivery status %+v", s)
+	}
+	// Kept after restart and retried with backoff
+	fail = false
+	ob = newOutbox(list)
+	ob.retryMap[d.ID].Next = now.Add(time.Minute * 6).Add(time.Second * 10)
+	ob.retry(now.Add(time.Minute*6), false)
+	if len(sent) != 0 {
+		t.Errorf("retry before backoff %v", sent)
+	}
+	ob.retry(now.Add(time.Minute*7), false)
+	if len(sent) != 1 || len(sent[0]) != 2 || sent[0][0] != "rule1" || sent[0][1] != "rule2" {
+		t.Fatalf("invalid digest %v", sent)
+	}
+	if c, _ := countTestOutbox(); c != 0 {
+		t.Errorf("outbox not empty %d", c)
+	}
+	if s := getTestDelivery(n1); s == nil || s.Status != "sent" || s.Attempts != 2 {
+		t.Errorf("invalid delivery status %+v", s)
+	}
+	// Flush on stop
+	ob.deliver(d, n2, now.Add(time.Minute*8))
+	ob.flushDigest(now.Add(time.Minute * 8))
+	if len(sent) != 2 || len(sent[1]) != 1 {
+		t.Errorf("digest not flushed %v", sent)
+	}
+}
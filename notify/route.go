@@ -0,0 +1,495 @@
+package notify
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// Route is space separated "key=value" list like
+// name=soc level=high+ tag=attack.credential-access dst=webhook:1|mail rate=10/1h dedup=10m schedule=mon-fri/09:00-18:00
+// Multiple values are separated by "|".
+// Routes are evaluated in order and the first matched route is used unless continue=true.
+// Unmatched notifications fall through to the route named default.
+// action=<name> runs response actions on the route.
+
+const defaultRouteName = "default"
+
+type notifyDstEnt struct {
+	// Name is "<kind>:<index>" like webhook:1. Index is the position in config.
+	Name string
+	// ID is "<kind>:<hash of config>" and is saved in outbox and escalation.
+	// It does not change when config entries are reordered.
+	ID   string
+	Kind string
+	send func(n *datastore.NotifyEnt) error
+	// Digest interval. Deliveries wait in outbox and are sent together by sendDigest.
+	digest     time.Duration
+	sendDigest func(list []*datastore.NotifyEnt) error
+}
+
+// addNotifyDst adds destination of config entry i to list.
+func addNotifyDst(list []*notifyDstEnt, kind string, i int, cfg string, send func(n *datastore.NotifyEnt) error) []*notifyDstEnt {
+	h := sha256.Sum256([]byte(cfg))
+	id := fmt.Sprintf("%s:%x", kind, h[:6])
+	// Same config entries
+	dup := 1
+	for _, d := range list {
+		if strings.HasPrefix(d.ID, id) {
+			dup++
+		}
+	}
+	if dup > 1 {
+		id += fmt.Sprintf("-%d", dup)
+	}
+	return append(list, &notifyDstEnt{
+		Name: fmt.Sprintf("%s:%d", kind, i+1),
+		ID:   id,
+		Kind: kind,
+		send: send,
+	})
+}
+
+type routeScheduleEnt struct {
+	// Bit mask of weekday
+	Days  int
+	Start int
+	End   int
+}
+
+type notifyRouteEnt struct {
+	Name      string
+	Levels    []string
+	IDs       []string
+	Tags      []string
+	Types     []string
+	Nets      []*net.IPNet
+	Hosts     []string
+	Dst       []string
+	Actions   []string
+	Schedules []routeScheduleEnt
+	RateCount int
+	RateSpan  time.Duration
+	Dedup     time.Duration
+	Continue  bool
+	mu        sync.Mutex
+	sent      []time.Time
+	dedupMap  map[string]time.Time
+}
+
+var levelOrder = map[string]int{
+	"informational": 0,
+	"info":          0,
+	"low":           1,
+	"medium":        2,
+	"high":          3,
+	"critical":      4,
+}
+
+var weekdayMap = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func getNotifyRoutes(list []string) []*notifyRouteEnt {
+	ret := []*notifyRouteEnt{}
+	for i, s := range list {
+		r, err := parseNotifyRoute(s)
+		if err != nil {
+			log.Fatalf("invalid notify route %s err=%v", s, err)
+		}
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("route%d", i+1)
+		}
+		ret = append(ret, r)
+	}
+	return ret
+}
+
+func parseNotifyRoute(s string) (*notifyRouteEnt, error) {
+	r := &notifyRouteEnt{
+		dedupMap: make(map[string]time.Time),
+	}
+	for _, f := range strings.Fields(s) {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid field %s", f)
+		}
+		vals := strings.Split(kv[1], "|")
+		switch strings.ToLower(kv[0]) {
+		case "name":
+			r.Name = kv[1]
+		case "level":
+			for _, l := range vals {
+				if _, ok := levelOrder[strings.TrimSuffix(l, "+")]; !ok {
+					return nil, fmt.Errorf("invalid level %s", l)
+				}
+			}
+			r.Levels = vals
+		case "id":
+			r.IDs = vals
+		case "tag":
+			r.Tags = vals
+		case "type":
+			r.Types = vals
+		case "src":
+			for _, v := range vals {
+				if _, n, err := net.ParseCIDR(v); err == nil {
+					r.Nets = append(r.Nets, n)
+				} else if ip := net.ParseIP(v); ip != nil {
+					bits := 32
+					if ip.To4() == nil {
+						bits = 128
+					}
+					r.Nets = append(r.Nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+				} else {
+					r.Hosts = append(r.Hosts, strings.ToLower(v))
+				}
+			}
+		case "dst":
+			r.Dst = vals
+		case "action":
+			r.Actions = vals
+		case "rate":
+			a := strings.SplitN(kv[1], "/", 2)
+			c, err := strconv.Atoi(a[0])
+			if err != nil || c < 1 || len(a) != 2 {
+				return nil, fmt.Errorf("invalid rate %s", kv[1])
+			}
+			d, err := time.ParseDuration(a[1])
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("invalid rate %s", kv[1])
+			}
+			r.RateCount = c
+			r.RateSpan = d
+		case "dedup":
+			d, err := time.ParseDuration(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			r.Dedup = d
+		case "schedule":
+			for _, v := range vals {
+				sc, err := parseRouteSchedule(v)
+				if err != nil {
+					return nil, err
+				}
+				r.Schedules = append(r.Schedules, sc)
+			}
+		case "continue":
+			r.Continue = kv[1] == "true"
+		default:
+			return nil, fmt.Errorf("unknown field %s", kv[0])
+		}
+	}
+	return r, nil
+}
+
+// parseRouteSchedule parses "mon-fri/09:00-18:00", "sat|sun" or "22:00-06:00".
+func parseRouteSchedule(s string) (routeScheduleEnt, error) {
+	ret := routeScheduleEnt{Days: 0x7f, Start: 0, End: 24 * 60}
+	days := s
+	times := ""
+	if i := strings.Index(s, "/"); i >= 0 {
+		days = s[:i]
+		times = s[i+1:]
+	} else if strings.Contains(s, ":") {
+		days = ""
+		times = s
+	}
+	if days != "" {
+		a := strings.SplitN(strings.ToLower(days), "-", 2)
+		st, ok := weekdayMap[a[0]]
+		if !ok {
+			return ret, fmt.Errorf("invalid schedule %s", s)
+		}
+		et := st
+		if len(a) == 2 {
+			if et, ok = weekdayMap[a[1]]; !ok {
+				return ret, fmt.Errorf("invalid schedule %s", s)
+			}
+		}
+		ret.Days = 0
+		for d := st; ; d = (d + 1) % 7 {
+			ret.Days |= 1 << d
+			if d == et {
+				break
+			}
+		}
+	}
+	if times != "" {
+		a := strings.SplitN(times, "-", 2)
+		if len(a) != 2 {
+			return ret, fmt.Errorf("invalid schedule %s", s)
+		}
+		var err error
+		if ret.Start, err = parseRouteTime(a[0]); err != nil {
+			return ret, err
+		}
+		if ret.End, err = parseRouteTime(a[1]); err != nil {
+			return ret, err
+		}
+	}
+	return ret, nil
+}
+
+func parseRouteTime(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		if s == "24:00" {
+			return 24 * 60, nil
+		}
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// active checks schedule. Time range over midnight like 22:00-06:00 belongs to start day.
+func (sc *routeScheduleEnt) active(now time.Time) bool {
+	m := now.Hour()*60 + now.Minute()
+	d := now.Weekday()
+	if sc.Start <= sc.End {
+		return sc.Days&(1<<d) != 0 && m >= sc.Start && m < sc.End
+	}
+	if m >= sc.Start {
+		return sc.Days&(1<<d) != 0
+	}
+	if m < sc.End {
+		return sc.Days&(1<<((d+6)%7)) != 0
+	}
+	return false
+}
+
+func (r *notifyRouteEnt) match(n *datastore.NotifyEnt, now time.Time) bool {
+	if len(r.Schedules) > 0 {
+		ok := false
+		for i := range r.Schedules {
+			if r.Schedules[i].active(now) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(r.Levels) > 0 && !matchRouteLevel(r.Levels, n.Level) {
+		return false
+	}
+	if len(r.IDs) > 0 && !matchRoutePattern(r.IDs, n.ID) {
+		return false
+	}
+	if len(r.Types) > 0 && !matchRoutePattern(r.Types, n.Type.String()) {
+		return false
+	}
+	if len(r.Tags) > 0 {
+		ok := false
+		for _, t := range strings.Split(n.Tags, ";") {
+			if t != "" && matchRoutePattern(r.Tags, t) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(r.Nets) > 0 || len(r.Hosts) > 0 {
+		return r.matchSrc(n.Src)
+	}
+	return true
+}
+
+func matchRouteLevel(list []string, level string) bool {
+	lv, ok := levelOrder[level]
+	for _, l := range list {
+		if strings.HasSuffix(l, "+") {
+			if ok && lv >= levelOrder[strings.TrimSuffix(l, "+")] {
+				return true
+			}
+		} else if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+func matchRoutePattern(list []string, s string) bool {
+	for _, p := range list {
+		if ok, err := path.Match(p, s); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *notifyRouteEnt) matchSrc(src string) bool {
+	host := src
+	if h, _, err := net.SplitHostPort(src); err == nil {
+		host = h
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		for _, n := range r.Nets {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+		if len(r.Hosts) > 0 && datastore.Config.ResolveHostName {
+			host = strings.TrimSuffix(datastore.GetHostByIP(host), ".")
+		}
+	}
+	return matchRoutePattern(r.Hosts, strings.ToLower(host))
+}
+
+// allow checks dedup window and rate limit of route.
+func (r *notifyRouteEnt) allow(n *datastore.NotifyEnt, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Dedup > 0 {
+		k := n.ID + "\t" + n.Src
+		if t, ok := r.dedupMap[k]; ok && now.Sub(t) < r.Dedup {
+			return false
+		}
+		r.dedupMap[k] = now
+	}
+	if r.RateCount > 0 {
+		i := 0
+		for i < len(r.sent) && now.Sub(r.sent[i]) >= r.RateSpan {
+			i++
+		}
+		r.sent = r.sent[i:]
+		if len(r.sent) >= r.RateCount {
+			return false
+		}
+		r.sent = append(r.sent, now)
+	}
+	return true
+}
+
+// cleanup removes expired dedup entries.
+func (r *notifyRouteEnt) cleanup(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, t := range r.dedupMap {
+		if now.Sub(t) >= r.Dedup {
+			delete(r.dedupMap, k)
+		}
+	}
+}
+
+// selectDst returns destinations of route.
+func (r *notifyRouteEnt) selectDst(all []*notifyDstEnt) []*notifyDstEnt {
+	if len(r.Dst) < 1 {
+		return all
+	}
+	return selectNotifyDst(r.Dst, all)
+}
+
+// selectNotifyDst returns destinations by name. Name is kind like webhook, kind:index like webhook:1 or ID.
+func selectNotifyDst(names []string, all []*notifyDstEnt) []*notifyDstEnt {
+	ret := []*notifyDstEnt{}
+	for _, d := range all {
+		for _, name := range names {
+			if name == d.Name || name == d.Kind || name == d.ID {
+				ret = append(ret, d)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// routeNotify returns destinations for notification.
+func routeNotify(routes []*notifyRouteEnt, all []*notifyDstEnt, n *datastore.NotifyEnt, now time.Time) []*notifyDstEnt {
+	ret, _, _ := selectNotifyRoute(routes, all, n, now)
+	return ret
+}
+
+// selectNotifyRoute returns destinations, names of matched routes and actions of allowed routes.
+func selectNotifyRoute(routes []*notifyRouteEnt, all []*notifyDstEnt, n *datastore.NotifyEnt, now time.Time) ([]*notifyDstEnt, []string, []string) {
+	if len(routes) < 1 {
+		return all, nil, nil
+	}
+	ret := []*notifyDstEnt{}
+	names := []string{}
+	actions := []string{}
+	var defRoute *notifyRouteEnt
+	for _, r := range routes {
+		if r.Name == defaultRouteName {
+			defRoute = r
+			continue
+		}
+		if !r.match(n, now) {
+			continue
+		}
+		names = append(names, r.Name)
+		if r.allow(n, now) {
+			ret = appendNotifyDst(ret, r.selectDst(all))
+			actions = appendRouteActions(actions, r.Actions)
+		} else if datastore.Config.Debug {
+			log.Printf("notify route %s suppress %s %s", r.Name, n.Src, n.ID)
+		}
+		if !r.Continue {
+			break
+		}
+	}
+	if len(names) > 0 {
+		return ret, names, actions
+	}
+	if defRoute == nil {
+		return all, nil, nil
+	}
+	if !defRoute.match(n, now) {
+		return ret, nil, nil
+	}
+	names = append(names, defRoute.Name)
+	if !defRoute.allow(n, now) {
+		return ret, names, nil
+	}
+	return defRoute.selectDst(all), names, defRoute.Actions
+}
+
+func appendRouteActions(list, add []string) []string {
+	for _, a := range add {
+		found := false
+		for _, e := range list {
+			if e == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, a)
+		}
+	}
+	return list
+}
+
+func appendNotifyDst(list, add []*notifyDstEnt) []*notifyDstEnt {
+	for _, a := range add {
+		found := false
+		for _, d := range list {
+			if d == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, a)
+		}
+	}
+	return list
+}
@@ -0,0 +1,139 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func getTestDstList() []*notifyDstEnt {
+	ret := []*notifyDstEnt{}
+	for _, name := range []string{"syslog:1", "webhook:1", "webhook:2", "mail:1"} {
+		ret = append(ret, &notifyDstEnt{
+			Name: name,
+			Kind: strings.SplitN(name, ":", 2)[0],
+		})
+	}
+	return ret
+}
+
+func getTestDstNames(list []*notifyDstEnt) string {
+	names := []string{}
+	for _, d := range list {
+		names = append(names, d.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func TestNotifyRoute(t *testing.T) {
+	routes := getNotifyRoutes([]string{
+		"name=soc level=high+ tag=attack.credential-access dst=webhook:2|mail rate=2/1h",
+		"name=net src=10.0.0.0/8|*.example.com type=netflow|anomalyReport dst=syslog dedup=10m",
+		"name=night level=critical schedule=sat-sun|22:00-06:00 dst=mail continue=true",
+		"name=default dst=webhook",
+	})
+	all := getTestDstList()
+	now := time.Date(2025, 10, 27, 12, 0, 0, 0, time.Local) // Monday
+	n := getTestNotify()
+	if s := getTestDstNames(routeNotify(routes, all, n, now)); s != "webhook:2,mail:1" {
+		t.Errorf("invalid soc route %s", s)
+	}
+	routeNotify(routes, all, n, now)
+	// Rate limit
+	if s := getTestDstNames(routeNotify(routes, all, n, now)); s != "" {
+		t.Errorf("rate limit not work %s", s)
+	}
+	if s := getTestDstNames(routeNotify(routes, all, n, now.Add(time.Hour))); s != "webhook:2,mail:1" {
+		t.Errorf("rate limit not reset %s", s)
+	}
+	// Level does not match
+	n.Level = "medium"
+	if s := getTestDstNames(routeNotify(routes, all, n, now)); s != "webhook:1,webhook:2" {
+		t.Errorf("invalid default route %s", s)
+	}
+	// Source CIDR and dedup
+	n = &datastore.NotifyEnt{Type: datastore.NetFlow, Src: "10.1.2.3", ID: "x", Level: "low"}
+	if s := getTestDstNames(routeNotify(routes, all, n, now)); s != "syslog:1" {
+		t.Errorf("invalid net route %s", s)
+	}
+	if s := getTestDstNames(routeNotify(routes, all, n, now.Add(time.Minute))); s != "" {
+		t.Errorf("dedup not work %s", s)
+	}
+	n.Src = "host1.example.com"
+	if s := getTestDstNames(routeNotify(routes, all, n, now.Add(time.Minute))); s != "syslog:1" {
+		t.Errorf("invalid host route %s", s)
+	}
+	// Schedule and continue
+	n = &datastore.NotifyEnt{Type: datastore.Syslog, Src: "192.168.1.1", ID: "y", Level: "critical"}
+	if s := getTestDstNames(routeNotify(routes, all, n, now)); s != "webhook:1,webhook:2" {
+		t.Errorf("schedule must be inactive %s", s)
+	}
+	if s := getTestDstNames(routeNotify(routes, all, n, now.Add(time.Hour*11))); s != "mail:1" {
+		t.Errorf("schedule must be active at night %s", s)
+	}
+	if s := getTestDstNames(routeNotify(routes, all, n, now.Add(time.Hour*-7))); s != "mail:1" {
+		t.Errorf("22:00-06:00 must be active on early morning %s", s)
+	}
+	// No route
+	if s := getTestDstNames(routeNotify(nil, all, n, now)); s != "syslog:1,webhook:1,webhook:2,mail:1" {
+		t.Errorf("invalid no route %s", s)
+	}
+}
+
+func TestAddNotifyDst(t *testing.T) {
+	// Second entry is invalid and skipped
+	list := addNotifyDst(nil, "webhook", 0, "https://example.com/a", nil)
+	list = addNotifyDst(list, "webhook", 2, "https://example.com/b", nil)
+	list = addNotifyDst(list, "webhook", 3, "https://example.com/a", nil)
+	if s := getTestDstNames(list); s != "webhook:1,webhook:3,webhook:4" {
+		t.Errorf("invalid names %s", s)
+	}
+	if list[0].ID == list[1].ID || list[2].ID != list[0].ID+"-2" {
+		t.Errorf("invalid id %s %s %s", list[0].ID, list[1].ID, list[2].ID)
+	}
+	// ID does not depend on order
+	r := addNotifyDst(nil, "webhook", 0, "https://example.com/b", nil)
+	if r[0].ID != list[1].ID {
+		t.Errorf("id changed by order %s %s", r[0].ID, list[1].ID)
+	}
+	if s := getTestDstNames(selectNotifyDst([]string{list[1].ID}, list)); s != "webhook:3" {
+		t.Errorf("invalid select by id %s", s)
+	}
+}
+
+func TestParseNotifyRoute(t *testing.T) {
+	for _, s := range []string{
+		"level=unknown",
+		"rate=10",
+		"schedule=xyz/09:00-10:00",
+		"foo=bar",
+		"dedup=abc",
+	} {
+		if _, err := parseNotifyRoute(s); err == nil {
+			t.Errorf("parse route %s must be error", s)
+		}
+	}
+}
+
+func TestRouteSchedule(t *testing.T) {
+	sc, err := parseRouteSchedule("fri/22:00-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		t      time.Time
+		active bool
+	}{
+		{time.Date(2025, 10, 31, 23, 0, 0, 0, time.Local), true},  // Fri 23:00
+		{time.Date(2025, 11, 1, 3, 0, 0, 0, time.Local), true},    // Sat 03:00
+		{time.Date(2025, 11, 1, 23, 0, 0, 0, time.Local), false},  // Sat 23:00
+		{time.Date(2025, 10, 31, 3, 0, 0, 0, time.Local), false},  // Fri 03:00
+		{time.Date(2025, 10, 31, 12, 0, 0, 0, time.Local), false}, // Fri 12:00
+	} {
+		if sc.active(c.t) != c.active {
+			t.Errorf("schedule %v active=%v", c.t, !c.active)
+		}
+	}
+}
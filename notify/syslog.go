@@ -0,0 +1,194 @@
+package notify
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// syslogDstEnt is syslog destination.
+// Destination is "host:port" (UDP) or URL like
+// tcp://host:514?format=3164&facility=19 or tls://host:6514?ca=ca.crt&cert=client.crt&key=client.key
+// TCP and TLS use octet-counting framing (RFC 6587, RFC 5425).
+type syslogDstEnt struct {
+	Network  string
+	Addr     string
+	Format   string
+	Facility int
+	Template *notifyTemplateEnt
+	tls      *tls.Config
+	conn     net.Conn
+}
+
+// Private enterprise number of twsnmp (same as trap OID) for SD-ID
+const syslogSDID = "twlogeye@17861"
+
+func getSyslogDst(d string) *syslogDstEnt {
+	t, d := splitNotifyDst(d)
+	e := &syslogDstEnt{
+		Network:  "udp",
+		Format:   "5424",
+		Facility: 19,
+		Template: getNotifyTemplateOrDie(t),
+	}
+	if !strings.Contains(d, "://") {
+		d = "udp://" + d
+	}
+	u, err := url.Parse(d)
+	if err != nil || u.Hostname() == "" {
+		log.Printf("invalid syslog dst %s err=%v", d, err)
+		return nil
+	}
+	port := u.Port()
+	switch u.Scheme {
+	case "udp":
+	case "tcp":
+		e.Network = "tcp"
+	case "tls":
+		e.Network = "tls"
+		if port == "" {
+			port = "6514"
+		}
+		if e.tls, err = getNotifyTLSConfig(u.Query()); err != nil {
+			log.Printf("invalid syslog dst %s err=%v", d, err)
+			return nil
+		}
+		e.tls.ServerName = u.Hostname()
+	default:
+		log.Printf("invalid syslog dst %s scheme=%s", d, u.Scheme)
+		return nil
+	}
+	if port == "" {
+		port = "514"
+	}
+	e.Addr = net.JoinHostPort(u.Hostname(), port)
+	q := u.Query()
+	switch q.Get("format") {
+	case "", "5424":
+	case "3164":
+		e.Format = "3164"
+	default:
+		log.Printf("invalid syslog dst %s format=%s", d, q.Get("format"))
+		return nil
+	}
+	if f := q.Get("facility"); f != "" {
+		if v, err := strconv.Atoi(f); err == nil && v >= 0 && v < 24 {
+			e.Facility = v
+		} else {
+			log.Printf("invalid syslog dst %s facility=%s", d, f)
+			return nil
+		}
+	}
+	log.Printf("syslog dst %s://%s format=%s", e.Network, e.Addr, e.Format)
+	return e
+}
+
+// connect connects to destination. Connection is made again after error.
+func (d *syslogDstEnt) connect() error {
+	if d.conn != nil {
+		return nil
+	}
+	var err error
+	dialer := &net.Dialer{Timeout: time.Second * 5}
+	if d.Network == "tls" {
+		d.conn, err = tls.DialWithDialer(dialer, "tcp", d.Addr, d.tls)
+	} else {
+		d.conn, err = dialer.Dial(d.Network, d.Addr)
+	}
+	return err
+}
+
+func (d *syslogDstEnt) close() {
+	if d.conn != nil {
+		d.conn.Close()
+		d.conn = nil
+	}
+}
+
+func (d *syslogDstEnt) write(msg string) error {
+	if err := d.connect(); err != nil {
+		return err
+	}
+	if d.Network != "udp" {
+		// Octet-counting
+		msg = fmt.Sprintf("%d %s", len(msg), msg)
+	}
+	d.conn.SetWriteDeadline(time.Now().Add(time.Second * 5))
+	if _, err := d.conn.Write([]byte(msg)); err != nil {
+		d.close()
+		return err
+	}
+	return nil
+}
+
+func getSyslogSeverity(l string) int {
+	switch l {
+	case "low":
+		return 5 // notice
+	case "medium":
+		return 4 // warning
+	case "high":
+		return 3 // error
+	case "critical":
+		return 2 // critical
+	//	case "informational", "info":
+	default:
+		return 6
+	}
+}
+
+func sendSyslog(d *syslogDstEnt, host string, n *datastore.NotifyEnt) error {
+	return d.write(makeSyslogMessage(d, host, n, time.Now()))
+}
+
+func makeSyslogMessage(d *syslogDstEnt, host string, n *datastore.NotifyEnt, now time.Time) string {
+	msg := n.Title
+	if d.Template != nil {
+		if b, err := d.Template.render(n); err == nil {
+			msg = string(b)
+		} else {
+			log.Printf("syslog template err=%v", err)
+		}
+	}
+	pri := d.Facility*8 + getSyslogSeverity(n.Level)
+	sd := makeSyslogSD(n)
+	if d.Format == "3164" {
+		return fmt.Sprintf("<%d>%s %s twlogeye[%d]: %s %s", pri, now.Format(time.Stamp), host, os.Getpid(), sd, msg)
+	}
+	return fmt.Sprintf("<%d>1 %s %s twlogeye %d notify %s %s",
+		pri, now.Format("2006-01-02T15:04:05.000000Z07:00"), host, os.Getpid(), sd, msg)
+}
+
+// makeSyslogSD makes SD-ELEMENT of notify.
+func makeSyslogSD(n *datastore.NotifyEnt) string {
+	params := [][2]string{
+		{"id", n.ID},
+		{"level", n.Level},
+		{"tags", n.Tags},
+		{"src", n.Src},
+		{"logtype", n.Type.String()},
+	}
+	if n.IncidentID != "" {
+		params = append(params, [2]string{"incident", n.IncidentID})
+	}
+	if n.Count > 1 {
+		params = append(params, [2]string{"count", strconv.Itoa(n.Count)})
+	}
+	if n.Escalation > 0 {
+		params = append(params, [2]string{"escalation", strconv.Itoa(n.Escalation)})
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+	sd := "[" + syslogSDID
+	for _, p := range params {
+		sd += fmt.Sprintf(` %s="%s"`, p[0], r.Replace(p[1]))
+	}
+	return sd + "]"
+}
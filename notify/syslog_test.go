@@ -0,0 +1,150 @@
+package notify
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSyslogMessage(t *testing.T) {
+	n := getTestNotify()
+	n.Tags = `a]b;"c\`
+	n.Count = 3
+	now := time.Date(2025, 10, 26, 11, 0, 0, 123000000, time.UTC)
+	d := getSyslogDst("192.168.1.1")
+	if d == nil || d.Network != "udp" || d.Addr != "192.168.1.1:514" || d.Format != "5424" {
+		t.Fatalf("invalid dst %+v", d)
+	}
+	m := makeSyslogMessage(d, "host1", n, now)
+	re := regexp.MustCompile(`^<155>1 2025-10-26T11:00:00\.123000Z host1 twlogeye \d+ notify \[twlogeye@17861 id="rule1" level="high" tags="a\\]b;\\"c\\\\" src="192\.168\.1\.1" logtype="syslog" count="3"\] Login "failed"$`)
+	if !re.MatchString(m) {
+		t.Errorf("invalid RFC5424 message %s", m)
+	}
+	d = getSyslogDst("tcp://192.168.1.1?format=3164&facility=1")
+	if d == nil || d.Network != "tcp" || d.Format != "3164" || d.Facility != 1 {
+		t.Fatalf("invalid dst %+v", d)
+	}
+	m = makeSyslogMessage(d, "host1", n, now)
+	if !strings.HasPrefix(m, "<11>Oct 26 11:00:00 host1 twlogeye[") || !strings.HasSuffix(m, `count="3"] Login "failed"`) {
+		t.Errorf("invalid RFC3164 message %s", m)
+	}
+	if d := getSyslogDst("tls://192.168.1.1"); d == nil || d.Addr != "192.168.1.1:6514" || d.tls == nil {
+		t.Errorf("invalid tls dst %+v", d)
+	}
+	for _, s := range []string{"http://192.168.1.1", "tcp://192.168.1.1?format=x", "udp://192.168.1.1?facility=24"} {
+		if getSyslogDst(s) != nil {
+			t.Errorf("no error %s", s)
+		}
+	}
+}
+
+func TestSyslogUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+	d := getSyslogDst(pc.LocalAddr().String())
+	defer d.close()
+	if err := sendSyslog(d, "host1", getTestNotify()); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 2048)
+	pc.SetReadDeadline(time.Now().Add(time.Second * 2))
+	l, _, err := pc.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := string(buf[:l]); !strings.HasPrefix(m, "<155>1 ") {
+		t.Errorf("invalid message %s", m)
+	}
+}
+
+func readTestOctetCounting(r *bufio.Reader) (string, error) {
+	s, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	l, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, l)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func TestSyslogTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	ch := make(chan string, 10)
+	serve := func(l net.Listener) {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				r := bufio.NewReader(c)
+				for {
+					m, err := readTestOctetCounting(r)
+					if err != nil {
+						return
+					}
+					ch <- m
+				}
+			}()
+		}
+	}
+	go serve(l)
+	d := getSyslogDst("tcp://" + addr)
+	defer d.close()
+	n := getTestNotify()
+	for i := 0; i < 2; i++ {
+		n.Title = fmt.Sprintf("title\n%d", i)
+		if err := sendSyslog(d, "host1", n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case m := <-ch:
+			if !strings.HasSuffix(m, fmt.Sprintf("title\n%d", i)) {
+				t.Errorf("invalid message %q", m)
+			}
+		case <-time.After(time.Second * 2):
+			t.Fatal("message not received")
+		}
+	}
+	// Reconnect after server restart
+	l.Close()
+	d.close()
+	if err := sendSyslog(d, "host1", n); err == nil {
+		t.Error("no error without server")
+	}
+	if l, err = net.Listen("tcp", addr); err != nil {
+		t.Skipf("listen again err=%v", err)
+	}
+	defer l.Close()
+	go serve(l)
+	if err := sendSyslog(d, "host1", n); err != nil {
+		t.Errorf("reconnect err=%v", err)
+	}
+	select {
+	case <-ch:
+	case <-time.After(time.Second * 2):
+		t.Error("message not received after reconnect")
+	}
+}
@@ -0,0 +1,252 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// Destination with template is "<preset or template file>=<destination>".
+
+type notifyTemplateEnt struct {
+	Name        string
+	ContentType string
+	Body        *template.Template
+	Headers     map[string]*template.Template
+}
+
+// notifyTemplateData is data for notify template.
+type notifyTemplateData struct {
+	Time  int64
+	Type  string
+	Src   string
+	Log   string
+	ID    string
+	Title string
+	Tags  string
+	Level string
+	Host  string
+	// Incident
+	IncidentID string
+	Count      int
+	FirstSeen  int64
+	LastSeen   int64
+	// Escalation step
+	Escalation int
+}
+
+type notifyPresetEnt struct {
+	ContentType string
+	Body        string
+	Headers     map[string]string
+}
+
+var notifyPresets = map[string]notifyPresetEnt{
+	"slack": {
+		ContentType: "application/json",
+		Body: `{"text":"[{{.Level}}] {{json .Title}}","attachments":[{"color":"{{color .Level}}","fields":[` +
+			`{"title":"Source","value":"{{json .Src}}","short":true},` +
+			`{"title":"Rule","value":"{{json .ID}}","short":true},` +
+			`{"title":"Tags","value":"{{json .Tags}}","short":false},` +
+			`{"title":"Log","value":"{{json (truncate .Log 1000)}}","short":false}],` +
+			`"footer":"twlogeye {{json .Host}}","ts":{{unix .Time}}}]}`,
+	},
+	"mattermost": {
+		ContentType: "application/json",
+		Body: `{"username":"twlogeye","text":"[{{.Level}}] {{json .Title}}","attachments":[{"color":"{{color .Level}}","fields":[` +
+			`{"title":"Source","value":"{{json .Src}}","short":true},` +
+			`{"title":"Rule","value":"{{json .ID}}","short":true},` +
+			`{"title":"Tags","value":"{{json .Tags}}","short":false},` +
+			`{"title":"Log","value":"{{json (truncate .Log 1000)}}","short":false}],` +
+			`"footer":"twlogeye {{json .Host}}","ts":{{unix .Time}}}]}`,
+	},
+	"teams": {
+		ContentType: "application/json",
+		Body: `{"@type":"MessageCard","@context":"http://schema.org/extensions",` +
+			`"themeColor":"{{trimPrefix (color .Level) "#"}}","summary":"{{json .Title}}",` +
+			`"title":"[{{.Level}}] {{json .Title}}","sections":[{"facts":[` +
+			`{"name":"Time","value":"{{formatTime .Time "2006-01-02 15:04:05"}}"},` +
+			`{"name":"Source","value":"{{json .Src}}"},` +
+			`{"name":"Rule","value":"{{json .ID}}"},` +
+			`{"name":"Tags","value":"{{json .Tags}}"}],` +
+			`"text":"{{json (truncate .Log 1000)}}"}]}`,
+	},
+	"discord": {
+		ContentType: "application/json",
+		Body: `{"username":"twlogeye","embeds":[{"title":"[{{.Level}}] {{json (truncate (or .Title .ID) 240)}}",` +
+			`"description":"{{json (truncate (or .Log "-") 2000)}}","color":{{colorInt .Level}},` +
+			`"timestamp":"{{formatTime .Time "2006-01-02T15:04:05Z07:00"}}","fields":[` +
+			`{"name":"Source","value":"{{json (or .Src "-")}}","inline":true},` +
+			`{"name":"Rule","value":"{{json (or .ID "-")}}","inline":true},` +
+			`{"name":"Tags","value":"{{json (or .Tags "-")}}","inline":false}]}]}`,
+	},
+	"ntfy": {
+		ContentType: "text/plain",
+		Body:        `{{.Src}} {{.ID}} {{truncate .Log 1000}}`,
+		Headers: map[string]string{
+			"Title":    `[{{.Level}}] {{.Title}}`,
+			"Priority": `{{priority .Level}}`,
+			"Tags":     `{{.Level}},{{replace .Tags ";" ","}}`,
+		},
+	},
+}
+
+var notifyTemplateFuncs = template.FuncMap{
+	"formatTime": func(t int64, layout string) string {
+		return time.Unix(0, t).Format(layout)
+	},
+	"unix": func(t int64) int64 {
+		return time.Unix(0, t).Unix()
+	},
+	"json": func(s string) string {
+		j, err := json.Marshal(s)
+		if err != nil || len(j) < 2 {
+			return ""
+		}
+		return string(j[1 : len(j)-1])
+	},
+	"truncate": func(s string, n int) string {
+		r := []rune(s)
+		if len(r) <= n {
+			return s
+		}
+		if n < 4 {
+			return string(r[:n])
+		}
+		return string(r[:n-3]) + "..."
+	},
+	"color": func(l string) string {
+		return fmt.Sprintf("#%06x", getLevelColor(l))
+	},
+	"colorInt": getLevelColor,
+	"priority": func(l string) int {
+		switch l {
+		case "critical":
+			return 5
+		case "high":
+			return 4
+		case "medium":
+			return 3
+		case "low":
+			return 2
+		}
+		return 1
+	},
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"replace":    strings.ReplaceAll,
+	"trimPrefix": strings.TrimPrefix,
+}
+
+func getLevelColor(l string) int {
+	switch l {
+	case "critical":
+		return 0x8b0000
+	case "high":
+		return 0xe03030
+	case "medium":
+		return 0xf0a030
+	case "low":
+		return 0x3080e0
+	}
+	return 0x808080
+}
+
+// splitNotifyDst splits "<template>=<dst>" to template and destination.
+func splitNotifyDst(d string) (string, string) {
+	i := strings.Index(d, "=")
+	if i < 1 {
+		return "", d
+	}
+	if j := strings.Index(d, "://"); j >= 0 && j < i {
+		// URL with query
+		return "", d
+	}
+	return d[:i], d[i+1:]
+}
+
+// getNotifyTemplate loads preset or template file.
+func getNotifyTemplate(name string) (*notifyTemplateEnt, error) {
+	p, ok := notifyPresets[name]
+	if !ok {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		p = notifyPresetEnt{
+			ContentType: "application/json",
+			Body:        string(b),
+		}
+		if s := strings.TrimSpace(string(b)); strings.HasPrefix(s, "{{") || (!strings.HasPrefix(s, "{") && !strings.HasPrefix(s, "[")) {
+			// Not JSON
+			p.ContentType = "text/plain"
+		}
+	}
+	t := &notifyTemplateEnt{
+		Name:        name,
+		ContentType: p.ContentType,
+		Headers:     make(map[string]*template.Template),
+	}
+	var err error
+	if t.Body, err = template.New(name).Funcs(notifyTemplateFuncs).Parse(p.Body); err != nil {
+		return nil, err
+	}
+	for k, v := range p.Headers {
+		h, err := template.New(k).Funcs(notifyTemplateFuncs).Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		t.Headers[k] = h
+	}
+	return t, nil
+}
+
+func getNotifyTemplateData(n *datastore.NotifyEnt) *notifyTemplateData {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+	return &notifyTemplateData{
+		Time:  n.Time,
+		Type:  n.Type.String(),
+		Src:   n.Src,
+		Log:   n.Log,
+		ID:    n.ID,
+		Title: n.Title,
+		Tags:  n.Tags,
+		Level: n.Level,
+		Host:  host,
+		// Incident
+		IncidentID: n.IncidentID,
+		Count:      n.Count,
+		FirstSeen:  n.FirstSeen,
+		LastSeen:   n.LastSeen,
+		Escalation: n.Escalation,
+	}
+}
+
+func (t *notifyTemplateEnt) render(n *datastore.NotifyEnt) ([]byte, error) {
+	b := new(bytes.Buffer)
+	if err := t.Body.Execute(b, getNotifyTemplateData(n)); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (t *notifyTemplateEnt) renderHeaders(n *datastore.NotifyEnt) map[string]string {
+	ret := make(map[string]string)
+	d := getNotifyTemplateData(n)
+	for k, h := range t.Headers {
+		b := new(bytes.Buffer)
+		if err := h.Execute(b, d); err == nil {
+			ret[k] = strings.TrimRight(b.String(), ",")
+		}
+	}
+	return ret
+}
@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func getTestNotify() *datastore.NotifyEnt {
+	return &datastore.NotifyEnt{
+		Time:  time.Date(2025, 10, 26, 11, 0, 0, 0, time.UTC).UnixNano(),
+		Type:  datastore.Syslog,
+		Src:   "192.168.1.1",
+		Log:   "login failed user=\"admin\"\nfrom <10.0.0.1>",
+		ID:    "rule1",
+		Title: "Login \"failed\"",
+		Tags:  "attack.t1110;attack.credential-access",
+		Level: "high",
+	}
+}
+
+func TestNotifyPresets(t *testing.T) {
+	n := getTestNotify()
+	for name := range notifyPresets {
+		tmpl, err := getNotifyTemplate(name)
+		if err != nil {
+			t.Fatalf("preset %s err=%v", name, err)
+		}
+		b, err := tmpl.render(n)
+		if err != nil {
+			t.Fatalf("preset %s err=%v", name, err)
+		}
+		if tmpl.ContentType == "application/json" && !json.Valid(b) {
+			t.Errorf("preset %s invalid json %s", name, b)
+		}
+	}
+	tmpl, _ := getNotifyTemplate("ntfy")
+	h := tmpl.renderHeaders(n)
+	if h["Priority"] != "4" || h["Title"] != "[high] Login \"failed\"" || h["Tags"] != "high,attack.t1110,attack.credential-access" {
+		t.Errorf("invalid ntfy headers %+v", h)
+	}
+}
+
+func TestNotifyTemplateFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "test.tmpl")
+	os.WriteFile(p, []byte(`{{formatTime .Time "2006-01-02"}} {{upper .Level}} {{truncate .Title 8}}`), 0600)
+	tmpl, err := getNotifyTemplate(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmpl.ContentType != "text/plain" {
+		t.Errorf("invalid content type %s", tmpl.ContentType)
+	}
+	b, err := tmpl.render(getTestNotify())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "2025-10-26 HIGH Login..." {
+		t.Errorf("invalid result %s", b)
+	}
+	if _, err := getNotifyTemplate("unknown"); err == nil {
+		t.Errorf("unknown template must be error")
+	}
+}
+
+func TestSplitNotifyDst(t *testing.T) {
+	tests := []struct {
+		d    string
+		tmpl string
+		dst  string
+	}{
+		{"192.168.1.1:514", "", "192.168.1.1:514"},
+		{"slack=https://hooks.slack.com/services/x", "slack", "https://hooks.slack.com/services/x"},
+		{"https://example.com/hook?a=b", "", "https://example.com/hook?a=b"},
+		{"/etc/twlogeye/syslog.tmpl=192.168.1.1", "/etc/twlogeye/syslog.tmpl", "192.168.1.1"},
+	}
+	for _, tc := range tests {
+		tmpl, dst := splitNotifyDst(tc.d)
+		if tmpl != tc.tmpl || dst != tc.dst {
+			t.Errorf("splitNotifyDst(%s)=%s,%s", tc.d, tmpl, dst)
+		}
+	}
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	ch := make(chan *http.Request, 2)
+	bodyCh := make(chan []byte, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		ch <- r
+		bodyCh <- b
+	}))
+	defer ts.Close()
+	list := []*webhookDstEnt{getWebhookDst("discord=" + ts.URL), getWebhookDst(ts.URL)}
+	for _, w := range list {
+		if err := sendWebhook(w, getTestNotify()); err != nil {
+			t.Fatalf("send webhook err=%v", err)
+		}
+	}
+	r := <-ch
+	b := <-bodyCh
+	var d map[string]any
+	if err := json.Unmarshal(b, &d); err != nil {
+		t.Fatalf("invalid discord payload %s", b)
+	}
+	if _, ok := d["embeds"]; !ok {
+		t.Errorf("invalid discord payload %s", b)
+	}
+	r = <-ch
+	b = <-bodyCh
+	if r.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("invalid content type")
+	}
+	var w webHookNotifyEnt
+	if err := json.Unmarshal(b, &w); err != nil || w.ID != "rule1" {
+		t.Errorf("invalid default payload %s", b)
+	}
+}
@@ -79,7 +79,12 @@ func saveMonitorReport() {
 			}
 		}
 	}
+	datastore.CheckDBQuota()
+	// Usage is scanned hourly because it reads all keys
+	m.Usage = datastore.GetCachedDBUsage(time.Hour)
 	m.DBSize = datastore.GetDBSize()
+	m.LSMSize, m.VlogSize = datastore.GetDBSizes()
+	m.LastGC = datastore.GetLastDBMaintenance()
 	if lastMonitorReport != nil {
 		if m.Time > lastMonitorReport.Time {
 			m.DBSpeed = float64(1000*1000*1000*(m.DBSize-lastMonitorReport.DBSize)) / float64(m.Time-lastMonitorReport.Time)
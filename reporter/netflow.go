@@ -32,6 +32,7 @@ var netflowFumbleSrcMap map[string]int
 var netflowHostMap map[string]int
 var netflowCountryMap map[string]int
 var netflowLocMap map[string]int
+var netflowZoneMap map[string]*netflowSummaryEnt
 
 func startNetflow(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
@@ -39,6 +40,9 @@ func startNetflow(ctx context.Context, wg *sync.WaitGroup) {
 		return
 	}
 	log.Printf("start netflow reporter")
+	if st := getNetflowScanTypes(); st != "" {
+		log.Printf("netflow scan detection %s", st)
+	}
 	timer := time.NewTicker(time.Second * 1)
 	lastT := getIntervalTime()
 	netflowReport = &datastore.NetflowReportEnt{}
@@ -50,6 +54,8 @@ func startNetflow(ctx context.Context, wg *sync.WaitGroup) {
 	netflowHostMap = make(map[string]int)
 	netflowCountryMap = make(map[string]int)
 	netflowLocMap = make(map[string]int)
+	netflowZoneMap = make(map[string]*netflowSummaryEnt)
+	netflowIfMap = make(map[string]*netflowIfSummaryEnt)
 	for {
 		select {
 		case <-ctx.Done():
@@ -58,6 +64,7 @@ func startNetflow(ctx context.Context, wg *sync.WaitGroup) {
 		case l := <-netflowReporterCh:
 			processNetflowReport(l)
 		case <-timer.C:
+			expireNetflowScan()
 			t := getIntervalTime()
 			if lastT != t {
 				lastT = t
@@ -177,9 +184,12 @@ func processNetflowReport(l *datastore.NetflowLogEnt) {
 			return
 		}
 		bytes = getNetflowInt64(l.Log["bytes"])
+		_, hasProtocol := l.Log["protocol"]
+		if hasProtocol {
+			pi = getNetflowInt(l.Log["protocol"])
+		}
 		if protocol, ok = l.Log["protocolStr"].(string); !ok {
-			if _, ok := l.Log["protocol"]; ok {
-				pi := getNetflowInt(l.Log["protocol"])
+			if hasProtocol {
 				switch pi {
 				case 1:
 					protocol = "icmp"
@@ -195,6 +205,13 @@ func processNetflowReport(l *datastore.NetflowLogEnt) {
 			}
 		}
 	}
+	// Packets of flow before scaling by sampling rate
+	flowPackets := packets
+	if rate := getNetflowInt64(l.Log["samplingRate"]); rate > 1 {
+		// Sampled flow
+		packets *= rate
+		bytes *= rate
+	}
 	netflowReport.Bytes += int64(bytes)
 	netflowReport.Packets += int64(packets)
 	if srcMAC != "" {
@@ -208,6 +225,7 @@ func processNetflowReport(l *datastore.NetflowLogEnt) {
 	if srcIP == "" {
 		return
 	}
+	checkNetflowIf(l, srcIP, packets, bytes)
 	if _, ok := netflowIPMap[srcIP]; !ok {
 		netflowIPMap[srcIP] = &netflowSummaryEnt{}
 	}
@@ -229,11 +247,31 @@ func processNetflowReport(l *datastore.NetflowLogEnt) {
 	netflowFlowMap[flow].Count++
 	netflowFlowMap[flow].Bytes += int64(bytes)
 	netflowFlowMap[flow].Packets += int(packets)
+	srcZone, ok := l.Log["srcZone"].(string)
+	if !ok {
+		srcZone = datastore.GetZone(srcIP)
+	}
+	dstZone, ok := l.Log["dstZone"].(string)
+	if !ok {
+		dstZone = datastore.GetZone(dstIP)
+	}
+	zone := srcZone + "\t" + dstZone
+	if _, ok := netflowZoneMap[zone]; !ok {
+		netflowZoneMap[zone] = &netflowSummaryEnt{}
+	}
+	netflowZoneMap[zone].Count++
+	netflowZoneMap[zone].Bytes += int64(bytes)
+	netflowZoneMap[zone].Packets += int(packets)
 	protocol = getProtocolName(protocol, int(sp), int(dp))
 	netflowProtocolMap[protocol]++
-	if src := isFumble(srcIP, dstIP, pi, sp, int(packets)); src != "" {
+	if src := isFumble(srcIP, dstIP, pi, sp, int(flowPackets)); src != "" {
 		netflowFumbleSrcMap[src]++
 	}
+	checkNetflowScan(l.Time, srcIP, dstIP, pi, sp, dp)
+	if datastore.Config.NetflowBeaconScore > 0 {
+		dstHost, _ := l.Log["dstHost"].(string)
+		checkNetflowBeacon(l.Time, srcIP, dstIP, pi, dp, dstHost)
+	}
 	if host, ok := l.Log["srcHost"].(string); ok {
 		netflowHostMap[host]++
 	}
@@ -426,6 +464,22 @@ func saveNetflowReport() {
 	}
 	netflowReport.TopCountryList = topCountryList
 	netflowReport.Country = len(netflowCountryMap)
+	zoneMatrix := []datastore.NetflowZoneEnt{}
+	for k, v := range netflowZoneMap {
+		a := strings.SplitN(k, "\t", 2)
+		if len(a) != 2 {
+			continue
+		}
+		zoneMatrix = append(zoneMatrix, datastore.NetflowZoneEnt{Src: a[0], Dst: a[1], Packets: int64(v.Packets), Bytes: v.Bytes, Flows: v.Count})
+	}
+	sort.Slice(zoneMatrix, func(i, j int) bool {
+		return zoneMatrix[i].Bytes > zoneMatrix[j].Bytes
+	})
+	netflowReport.ZoneMatrix = zoneMatrix
+
+	setNetflowScanReport()
+	checkNetflowBeaconReport()
+	setNetflowIfReport()
 
 	// Save Netflow Report
 	datastore.SaveNetflowReport(netflowReport)
@@ -443,6 +497,7 @@ func saveNetflowReport() {
 	netflowCountryMap = make(map[string]int)
 	netflowLocMap = make(map[string]int)
 	netflowFlowMap = make(map[string]*netflowSummaryEnt)
+	netflowZoneMap = make(map[string]*netflowSummaryEnt)
 	netflowReport = &datastore.NetflowReportEnt{}
 }
 
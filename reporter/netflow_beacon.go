@@ -0,0 +1,221 @@
+package reporter
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/montanaflynn/stats"
+	"github.com/twsnmp/twlogeye/auditor"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// netflowBeaconTrackEnt holds connection times of one conversation.
+type netflowBeaconTrackEnt struct {
+	Src      string
+	Dst      string
+	Port     int
+	Times    []int64
+	Last     int64
+	Notified int64
+}
+
+const netflowBeaconMaxTimes = 256
+
+var netflowBeaconMap = make(map[string]*netflowBeaconTrackEnt)
+
+func getNetflowBeaconWindow() int64 {
+	if datastore.Config.NetflowBeaconWindow < 1 {
+		return 6 * 3600 * 1000 * 1000 * 1000
+	}
+	return int64(datastore.Config.NetflowBeaconWindow) * 3600 * 1000 * 1000 * 1000
+}
+
+// isNetflowBeaconAllowed checks allow list.
+// Entry is IP, CIDR, ":port" or host name suffix.
+func isNetflowBeaconAllowed(dst, host string, port int) bool {
+	ip := net.ParseIP(dst)
+	for _, a := range datastore.Config.NetflowBeaconAllow {
+		a = strings.TrimSpace(a)
+		if a == "" {
+			continue
+		}
+		if strings.HasPrefix(a, ":") {
+			if a[1:] == fmt.Sprintf("%d", port) {
+				return true
+			}
+			continue
+		}
+		if _, n, err := net.ParseCIDR(a); err == nil {
+			if ip != nil && n.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if a == dst {
+			return true
+		}
+		if host != "" && (host == a || strings.HasSuffix(host, "."+strings.TrimPrefix(a, "."))) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkNetflowBeacon(t int64, src, dst string, prot, dp int, dstHost string) {
+	if prot != 6 && prot != 17 {
+		return
+	}
+	// Internal zone to Internet only
+	sz := datastore.GetZone(src)
+	if sz == "" || sz == "Internet" || datastore.GetZone(dst) != "Internet" || !isGlobalUnicast(dst) {
+		return
+	}
+	if isNetflowBeaconAllowed(dst, dstHost, dp) {
+		return
+	}
+	k := fmt.Sprintf("%s\t%s\t%d", src, dst, dp)
+	e, ok := netflowBeaconMap[k]
+	if !ok {
+		evictNetflowBeacon()
+		e = &netflowBeaconTrackEnt{
+			Src:  src,
+			Dst:  dst,
+			Port: dp,
+		}
+		netflowBeaconMap[k] = e
+	}
+	if len(e.Times) > 0 {
+		// Same connection
+		d := t - e.Times[len(e.Times)-1]
+		if d >= 0 && d < 1000*1000*1000 {
+			return
+		}
+	}
+	e.Times = append(e.Times, t)
+	if len(e.Times) > netflowBeaconMaxTimes {
+		e.Times = e.Times[len(e.Times)-netflowBeaconMaxTimes:]
+	}
+	if t > e.Last {
+		e.Last = t
+	}
+}
+
+// evictNetflowBeacon removes least recently seen conversations when map is full.
+// 10% of entries are removed at once to avoid sorting on every new conversation.
+func evictNetflowBeacon() {
+	max := datastore.Config.NetflowBeaconMaxEntries
+	if max < 1 || len(netflowBeaconMap) < max {
+		return
+	}
+	list := make([]*netflowBeaconTrackEnt, 0, len(netflowBeaconMap))
+	for _, e := range netflowBeaconMap {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Last < list[j].Last
+	})
+	n := len(netflowBeaconMap) - max + 1 + max/10
+	if n > len(list) {
+		n = len(list)
+	}
+	for _, e := range list[:n] {
+		delete(netflowBeaconMap, fmt.Sprintf("%s\t%s\t%d", e.Src, e.Dst, e.Port))
+	}
+	log.Printf("evict netflow beacon track count=%d", n)
+}
+
+// calcNetflowBeaconScore returns interval(sec), jitter(sec) and score.
+// score = 1 - jitter / interval
+func calcNetflowBeaconScore(times []int64) (float64, float64, float64) {
+	if len(times) < 3 {
+		return 0, 0, 0
+	}
+	ts := make([]int64, len(times))
+	copy(ts, times)
+	sort.Slice(ts, func(i, j int) bool {
+		return ts[i] < ts[j]
+	})
+	iv := []float64{}
+	for i := 1; i < len(ts); i++ {
+		iv = append(iv, float64(ts[i]-ts[i-1])/(1000*1000*1000))
+	}
+	mean, err := stats.Mean(iv)
+	if err != nil || mean <= 0 {
+		return 0, 0, 0
+	}
+	sd, err := stats.StandardDeviation(iv)
+	if err != nil {
+		return 0, 0, 0
+	}
+	score := 1.0 - sd/mean
+	if score < 0 {
+		score = 0
+	}
+	return mean, sd, score
+}
+
+// checkNetflowBeaconReport scores conversations and notifies new beacons.
+func checkNetflowBeaconReport() {
+	if datastore.Config.NetflowBeaconScore <= 0 {
+		return
+	}
+	et := time.Now().UnixNano() - getNetflowBeaconWindow()
+	list := []datastore.NetflowBeaconEnt{}
+	minCount := datastore.Config.NetflowBeaconMinCount
+	if minCount < 3 {
+		minCount = 3
+	}
+	for k, e := range netflowBeaconMap {
+		i := 0
+		for i < len(e.Times) && e.Times[i] < et {
+			i++
+		}
+		e.Times = e.Times[i:]
+		if len(e.Times) < 1 {
+			delete(netflowBeaconMap, k)
+			continue
+		}
+		if len(e.Times) < minCount {
+			continue
+		}
+		interval, jitter, score := calcNetflowBeaconScore(e.Times)
+		if score < datastore.Config.NetflowBeaconScore {
+			continue
+		}
+		b := datastore.NetflowBeaconEnt{
+			Time:     e.Times[len(e.Times)-1],
+			Src:      e.Src,
+			Dst:      e.Dst,
+			Port:     e.Port,
+			Count:    len(e.Times),
+			Interval: interval,
+			Jitter:   jitter,
+			Score:    score,
+		}
+		list = append(list, b)
+		if e.Notified > 0 && b.Time-e.Notified < getNetflowBeaconWindow() {
+			continue
+		}
+		e.Notified = b.Time
+		auditor.Audit(&datastore.LogEnt{
+			Time: b.Time,
+			Type: datastore.AnomalyReport,
+			Src:  "beacon:" + b.Src,
+			Log: fmt.Sprintf("beacon src=%s dst=%s port=%d count=%d interval=%.1fs jitter=%.1fs score=%.2f",
+				b.Src, b.Dst, b.Port, b.Count, b.Interval, b.Jitter, b.Score),
+		})
+		log.Printf("detect beacon src=%s dst=%s port=%d score=%.2f", b.Src, b.Dst, b.Port, b.Score)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Score > list[j].Score
+	})
+	netflowReport.Beacons = len(list)
+	if len(list) > datastore.Config.ReportTopN {
+		list = list[:datastore.Config.ReportTopN]
+	}
+	netflowReport.BeaconList = list
+}
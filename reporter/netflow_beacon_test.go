@@ -0,0 +1,103 @@
+package reporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/auditor"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func TestNetflowBeacon(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NetflowBeaconWindow = 6
+	datastore.Config.NetflowBeaconMinCount = 10
+	datastore.Config.NetflowBeaconScore = 0.8
+	datastore.Config.NetflowBeaconAllow = []string{":123", "203.0.113.0/24"}
+	datastore.Config.ReportTopN = 10
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	auditor.Init()
+	defer func() {
+		datastore.Config.NetflowBeaconScore = 0
+		datastore.Config.NetflowBeaconAllow = []string{}
+	}()
+	netflowBeaconMap = make(map[string]*netflowBeaconTrackEnt)
+	netflowReport = &datastore.NetflowReportEnt{}
+
+	st := time.Now().Add(-time.Hour).UnixNano()
+	jitter := []int64{0, 2, -1, 1, -2, 0, 1, -1, 2, 0, -2, 1, 0, 1, -1}
+	for i, j := range jitter {
+		ts := st + int64(i)*60*1000*1000*1000 + j*1000*1000*1000
+		// beacon
+		checkNetflowBeacon(ts, "192.168.1.10", "198.51.100.1", 6, 443, "")
+		// same connection is ignored
+		checkNetflowBeacon(ts+100, "192.168.1.10", "198.51.100.1", 6, 443, "")
+		// allowed
+		checkNetflowBeacon(ts, "192.168.1.10", "198.51.100.2", 17, 123, "")
+		checkNetflowBeacon(ts, "192.168.1.10", "203.0.113.1", 6, 443, "")
+		// internal
+		checkNetflowBeacon(ts, "192.168.1.10", "192.168.1.1", 6, 443, "")
+	}
+	// random intervals
+	ts := st
+	for _, d := range []int64{5, 300, 20, 900, 60, 1, 400, 30, 1200, 10, 600} {
+		ts += d * 1000 * 1000 * 1000
+		checkNetflowBeacon(ts, "192.168.1.11", "198.51.100.3", 6, 443, "")
+	}
+	if len(netflowBeaconMap) != 2 {
+		t.Fatalf("expected 2 conversations, got %d", len(netflowBeaconMap))
+	}
+	checkNetflowBeaconReport()
+	if netflowReport.Beacons != 1 || len(netflowReport.BeaconList) != 1 {
+		t.Fatalf("expected 1 beacon, got %d %+v", netflowReport.Beacons, netflowReport.BeaconList)
+	}
+	b := netflowReport.BeaconList[0]
+	if b.Src != "192.168.1.10" || b.Dst != "198.51.100.1" || b.Port != 443 || b.Count != len(jitter) {
+		t.Errorf("invalid beacon %+v", b)
+	}
+	if b.Interval < 55 || b.Interval > 65 {
+		t.Errorf("invalid beacon interval %f", b.Interval)
+	}
+	if b.Score < 0.8 {
+		t.Errorf("invalid beacon score %f", b.Score)
+	}
+}
+
+func TestNetflowBeaconZone(t *testing.T) {
+	datastore.Config.Zones = []string{"203.0.113.0/24=DMZ", "198.51.100.0/28=Partner"}
+	datastore.LoadZones()
+	datastore.Config.NetflowBeaconMaxEntries = 10
+	defer func() {
+		datastore.Config.Zones = []string{}
+		datastore.LoadZones()
+		datastore.Config.NetflowBeaconMaxEntries = 0
+	}()
+	netflowBeaconMap = make(map[string]*netflowBeaconTrackEnt)
+	st := time.Now().UnixNano()
+	// public address in zone is internal
+	checkNetflowBeacon(st, "203.0.113.10", "192.0.2.1", 6, 443, "")
+	// dst in zone is not Internet
+	checkNetflowBeacon(st, "192.168.1.10", "198.51.100.1", 6, 443, "")
+	// src in Internet
+	checkNetflowBeacon(st, "192.0.2.2", "192.0.2.1", 6, 443, "")
+	if len(netflowBeaconMap) != 1 {
+		t.Fatalf("expected 1 conversation, got %d", len(netflowBeaconMap))
+	}
+	if _, ok := netflowBeaconMap["203.0.113.10\t192.0.2.1\t443"]; !ok {
+		t.Errorf("zone src not tracked")
+	}
+	// max entries
+	for i := 0; i < 30; i++ {
+		checkNetflowBeacon(st+int64(i), "192.168.1.10", "192.0.2.1", 6, 1000+i, "")
+	}
+	if len(netflowBeaconMap) > 10 {
+		t.Errorf("beacon map not limited %d", len(netflowBeaconMap))
+	}
+	if _, ok := netflowBeaconMap["192.168.1.10\t192.0.2.1\t1029"]; !ok {
+		t.Errorf("latest conversation evicted")
+	}
+	if _, ok := netflowBeaconMap["203.0.113.10\t192.0.2.1\t443"]; ok {
+		t.Errorf("oldest conversation not evicted")
+	}
+}
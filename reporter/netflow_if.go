@@ -0,0 +1,212 @@
+package reporter
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/twsnmp/twlogeye/auditor"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// netflowIfSummaryEnt holds traffic of one interface of exporter.
+type netflowIfSummaryEnt struct {
+	Exporter   string
+	IfIndex    int
+	Name       string
+	InBytes    int64
+	OutBytes   int64
+	InPackets  int64
+	OutPackets int64
+	Talkers    map[string]int64
+}
+
+// netflowIfThresholdEnt is speed and utilisation threshold of interface.
+type netflowIfThresholdEnt struct {
+	Exporter string
+	If       string
+	Speed    float64
+	Percent  float64
+}
+
+var netflowIfMap = make(map[string]*netflowIfSummaryEnt)
+
+// Number of consecutive intervals over threshold
+var netflowIfOverMap = make(map[string]int)
+
+func getNetflowIf(exporter string, ifIndex int) *netflowIfSummaryEnt {
+	k := fmt.Sprintf("%s\t%d", exporter, ifIndex)
+	e, ok := netflowIfMap[k]
+	if !ok {
+		e = &netflowIfSummaryEnt{
+			Exporter: exporter,
+			IfIndex:  ifIndex,
+			Talkers:  make(map[string]int64),
+		}
+		netflowIfMap[k] = e
+	}
+	return e
+}
+
+// checkNetflowIf adds flow to input and output interface summary.
+func checkNetflowIf(l *datastore.NetflowLogEnt, srcIP string, packets, bytes int64) {
+	if l.Src == "" {
+		return
+	}
+	for _, k := range []string{"ingressInterface", "input"} {
+		if v, ok := l.Log[k]; ok {
+			if i := getNetflowInt(v); i > 0 {
+				e := getNetflowIf(l.Src, i)
+				e.InBytes += bytes
+				e.InPackets += packets
+				e.Talkers[srcIP] += bytes
+				if n, ok := l.Log["inputIfName"].(string); ok {
+					e.Name = n
+				}
+			}
+			break
+		}
+	}
+	for _, k := range []string{"egressInterface", "output"} {
+		if v, ok := l.Log[k]; ok {
+			if i := getNetflowInt(v); i > 0 {
+				e := getNetflowIf(l.Src, i)
+				e.OutBytes += bytes
+				e.OutPackets += packets
+				e.Talkers[srcIP] += bytes
+				if n, ok := l.Log["outputIfName"].(string); ok {
+					e.Name = n
+				}
+			}
+			break
+		}
+	}
+}
+
+// getNetflowIfThresholds parses threshold config.
+// Entry is "exporter ifIndex|ifName speedMbps percent". Exporter and interface can be "*".
+func getNetflowIfThresholds() []netflowIfThresholdEnt {
+	ret := []netflowIfThresholdEnt{}
+	for _, s := range datastore.Config.NetflowIfThreshold {
+		a := strings.Fields(s)
+		if len(a) < 3 {
+			log.Printf("invalid netflow interface threshold %s", s)
+			continue
+		}
+		speed, err := strconv.ParseFloat(a[2], 64)
+		if err != nil || speed <= 0 {
+			log.Printf("invalid netflow interface threshold %s", s)
+			continue
+		}
+		var percent float64
+		if len(a) > 3 {
+			if percent, err = strconv.ParseFloat(strings.TrimSuffix(a[3], "%"), 64); err != nil {
+				log.Printf("invalid netflow interface threshold %s", s)
+				continue
+			}
+		}
+		ret = append(ret, netflowIfThresholdEnt{
+			Exporter: a[0],
+			If:       a[1],
+			Speed:    speed,
+			Percent:  percent,
+		})
+	}
+	return ret
+}
+
+func findNetflowIfThreshold(list []netflowIfThresholdEnt, e *datastore.NetflowIfEnt) *netflowIfThresholdEnt {
+	for i, t := range list {
+		if t.Exporter != "*" && t.Exporter != e.Exporter {
+			continue
+		}
+		if t.If != "*" && t.If != fmt.Sprintf("%d", e.IfIndex) && t.If != e.Name {
+			continue
+		}
+		return &list[i]
+	}
+	return nil
+}
+
+// setNetflowIfReport makes interface list of report and checks utilisation.
+func setNetflowIfReport() {
+	sec := float64(datastore.Config.ReportInterval * 60)
+	if sec <= 0 {
+		sec = 300
+	}
+	thList := getNetflowIfThresholds()
+	count := datastore.Config.NetflowIfThresholdCount
+	if count < 1 {
+		count = 1
+	}
+	list := []datastore.NetflowIfEnt{}
+	over := make(map[string]int)
+	for k, v := range netflowIfMap {
+		e := datastore.NetflowIfEnt{
+			Exporter:   v.Exporter,
+			IfIndex:    v.IfIndex,
+			Name:       v.Name,
+			InBytes:    v.InBytes,
+			OutBytes:   v.OutBytes,
+			InPackets:  v.InPackets,
+			OutPackets: v.OutPackets,
+			InBps:      float64(v.InBytes*8) / sec,
+			OutBps:     float64(v.OutBytes*8) / sec,
+			InPps:      float64(v.InPackets) / sec,
+			OutPps:     float64(v.OutPackets) / sec,
+		}
+		talkers := []datastore.NetflowBytesSummaryEnt{}
+		for ip, b := range v.Talkers {
+			talkers = append(talkers, datastore.NetflowBytesSummaryEnt{Key: ip, Bytes: b})
+		}
+		sort.Slice(talkers, func(i, j int) bool {
+			return talkers[i].Bytes > talkers[j].Bytes
+		})
+		if len(talkers) > datastore.Config.ReportTopN {
+			talkers = talkers[:datastore.Config.ReportTopN]
+		}
+		e.TopTalkerList = talkers
+		if th := findNetflowIfThreshold(thList, &e); th != nil {
+			e.InUtil = e.InBps * 100.0 / (th.Speed * 1000 * 1000)
+			e.OutUtil = e.OutBps * 100.0 / (th.Speed * 1000 * 1000)
+			if th.Percent > 0 && (e.InUtil >= th.Percent || e.OutUtil >= th.Percent) {
+				over[k] = netflowIfOverMap[k] + 1
+				if over[k] == count {
+					notifyNetflowIf(&e, th, count)
+				}
+			}
+		}
+		list = append(list, e)
+	}
+	netflowIfOverMap = over
+	sort.Slice(list, func(i, j int) bool {
+		bi := list[i].InBytes + list[i].OutBytes
+		bj := list[j].InBytes + list[j].OutBytes
+		if bi != bj {
+			return bi > bj
+		}
+		if list[i].Exporter != list[j].Exporter {
+			return list[i].Exporter < list[j].Exporter
+		}
+		return list[i].IfIndex < list[j].IfIndex
+	})
+	netflowReport.IfList = list
+	netflowIfMap = make(map[string]*netflowIfSummaryEnt)
+}
+
+func notifyNetflowIf(e *datastore.NetflowIfEnt, th *netflowIfThresholdEnt, count int) {
+	name := e.Name
+	if name == "" {
+		name = fmt.Sprintf("%d", e.IfIndex)
+	}
+	auditor.Audit(&datastore.LogEnt{
+		Time: netflowReport.Time,
+		Type: datastore.AnomalyReport,
+		Src:  fmt.Sprintf("bandwidth:%s:%s", e.Exporter, name),
+		Log: fmt.Sprintf("bandwidth exporter=%s if=%s in=%.1f%% out=%.1f%% threshold=%.1f%% intervals=%d",
+			e.Exporter, name, e.InUtil, e.OutUtil, th.Percent, count),
+	})
+	log.Printf("detect bandwidth over exporter=%s if=%s in=%.1f%% out=%.1f%%", e.Exporter, name, e.InUtil, e.OutUtil)
+}
@@ -0,0 +1,74 @@
+package reporter
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/auditor"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func TestNetflowIf(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.ReportInterval = 1
+	datastore.Config.ReportTopN = 10
+	datastore.Config.NetflowIfThreshold = []string{"192.168.1.1 Gi0/1 1 50", "* * 1000 90"}
+	datastore.Config.NetflowIfThresholdCount = 2
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	auditor.Init()
+	defer func() {
+		datastore.Config.NetflowIfThreshold = []string{}
+	}()
+	netflowIfMap = make(map[string]*netflowIfSummaryEnt)
+	netflowIfOverMap = make(map[string]int)
+
+	for i := 0; i < 3; i++ {
+		netflowReport = &datastore.NetflowReportEnt{Time: time.Now().UnixNano()}
+		// 60MB in 60 sec = 8Mbps on 1Mbps link
+		checkNetflowIf(&datastore.NetflowLogEnt{
+			Src: "192.168.1.1",
+			Log: map[string]any{
+				"srcAddr":     net.ParseIP("192.168.2.1"),
+				"input":       uint16(1),
+				"output":      uint16(2),
+				"inputIfName": "Gi0/1",
+			},
+		}, "192.168.2.1", 1000, 60*1000*1000)
+		checkNetflowIf(&datastore.NetflowLogEnt{
+			Src: "192.168.1.1",
+			Log: map[string]any{
+				"input":  uint16(2),
+				"output": uint16(1),
+			},
+		}, "192.168.3.1", 10, 1000)
+		setNetflowIfReport()
+		if len(netflowReport.IfList) != 2 {
+			t.Fatalf("invalid interface list %+v", netflowReport.IfList)
+		}
+		// Both interfaces have same total bytes, so sorted by IfIndex
+		e := netflowReport.IfList[0]
+		if e.IfIndex != 1 || e.Name != "Gi0/1" || e.InBytes != 60*1000*1000 || e.OutBytes != 1000 {
+			t.Errorf("invalid interface %+v", e)
+		}
+		if e.InBps != 8*1000*1000 || e.InUtil != 800 {
+			t.Errorf("invalid interface bps %f util %f", e.InBps, e.InUtil)
+		}
+		if len(e.TopTalkerList) != 2 || e.TopTalkerList[0].Key != "192.168.2.1" {
+			t.Errorf("invalid top talkers %+v", e.TopTalkerList)
+		}
+		if netflowReport.IfList[1].OutPackets != 1000 {
+			t.Errorf("invalid interface %+v", netflowReport.IfList[1])
+		}
+		if netflowIfOverMap["192.168.1.1\t1"] != i+1 {
+			t.Errorf("invalid over count %d", netflowIfOverMap["192.168.1.1\t1"])
+		}
+		if _, ok := netflowIfOverMap["192.168.1.1\t2"]; ok {
+			t.Errorf("interface 2 is not over")
+		}
+	}
+	if len(netflowIfMap) != 0 {
+		t.Errorf("interface map not cleared")
+	}
+}
@@ -0,0 +1,219 @@
+package reporter
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/twsnmp/twlogeye/auditor"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+// netflowScanTrackEnt holds what one scan candidate touched in the window.
+type netflowScanTrackEnt struct {
+	First    int64
+	Last     int64
+	Items    map[string]int64
+	Ports    map[int]int64
+	Notified int64
+}
+
+// vertical: src -> dst many ports
+var netflowVScanMap = make(map[string]*netflowScanTrackEnt)
+
+// horizontal: src -> many dst on one port
+var netflowHScanMap = make(map[string]*netflowScanTrackEnt)
+
+// distributed: many src -> dst many ports
+var netflowDScanMap = make(map[string]*netflowScanTrackEnt)
+
+var netflowScanList = []datastore.NetflowScanEnt{}
+
+// netflowScanLastTime is the latest flow time seen, used to expire by flow time.
+var netflowScanLastTime int64
+
+// maxNetflowScanTargets limits targets in horizontal scan report.
+const maxNetflowScanTargets = 100
+
+func getNetflowScanWindow() int64 {
+	if datastore.Config.NetflowScanWindow < 1 {
+		return 60 * 1000 * 1000 * 1000
+	}
+	return int64(datastore.Config.NetflowScanWindow) * 1000 * 1000 * 1000
+}
+
+func getNetflowScanTrack(m map[string]*netflowScanTrackEnt, k string, t int64) *netflowScanTrackEnt {
+	e, ok := m[k]
+	if !ok {
+		e = &netflowScanTrackEnt{
+			First: t,
+			Items: make(map[string]int64),
+			Ports: make(map[int]int64),
+		}
+		m[k] = e
+	}
+	if t > e.Last {
+		e.Last = t
+	}
+	return e
+}
+
+// isNetflowScanProbe skips non TCP/UDP flows and replies from well known ports.
+func isNetflowScanProbe(prot, sp, dp int) bool {
+	if prot != 6 && prot != 17 {
+		return false
+	}
+	if sp < 1024 && dp >= 1024 {
+		return false
+	}
+	return true
+}
+
+func checkNetflowScan(t int64, src, dst string, prot, sp, dp int) {
+	if !isNetflowScanProbe(prot, sp, dp) {
+		return
+	}
+	if t > netflowScanLastTime {
+		netflowScanLastTime = t
+	}
+	if datastore.Config.NetflowScanPorts > 0 {
+		e := getNetflowScanTrack(netflowVScanMap, src+"\t"+dst, t)
+		e.Ports[dp] = t
+		if len(e.Ports) >= datastore.Config.NetflowScanPorts {
+			notifyNetflowScan(e, "vertical", src, dst, len(e.Ports), nil)
+		}
+	}
+	if datastore.Config.NetflowScanHosts > 0 {
+		e := getNetflowScanTrack(netflowHScanMap, fmt.Sprintf("%s\t%d", src, dp), t)
+		e.Items[dst] = t
+		e.Ports[dp] = t
+		if len(e.Items) >= datastore.Config.NetflowScanHosts {
+			notifyNetflowScan(e, "horizontal", src, fmt.Sprintf("%d hosts", len(e.Items)), len(e.Items), getNetflowScanTargets(e.Items))
+		}
+	}
+	if datastore.Config.NetflowScanSrcs > 0 {
+		e := getNetflowScanTrack(netflowDScanMap, dst, t)
+		e.Items[src] = t
+		e.Ports[dp] = t
+		if len(e.Items) >= datastore.Config.NetflowScanSrcs && len(e.Ports) >= max(datastore.Config.NetflowScanSrcPorts, 1) {
+			notifyNetflowScan(e, "distributed", fmt.Sprintf("%d hosts", len(e.Items)), dst, len(e.Ports), nil)
+		}
+	}
+}
+
+func notifyNetflowScan(e *netflowScanTrackEnt, st, src, dst string, count int, targets []string) {
+	if e.Notified > 0 && e.Last-e.Notified < getNetflowScanWindow() {
+		return
+	}
+	e.Notified = e.Last
+	sec := float64(e.Last-e.First) / (1000 * 1000 * 1000)
+	if sec < 1.0 {
+		sec = 1.0
+	}
+	s := datastore.NetflowScanEnt{
+		Time:      e.Last,
+		Type:      st,
+		Src:       src,
+		Dst:       dst,
+		PortRange: getNetflowScanPortRange(e.Ports),
+		Count:     count,
+		Rate:      float64(count) / sec,
+		Targets:   targets,
+	}
+	netflowScanList = append(netflowScanList, s)
+	msg := fmt.Sprintf("%s scan src=%s dst=%s ports=%s count=%d rate=%.2f/s", s.Type, s.Src, s.Dst, s.PortRange, s.Count, s.Rate)
+	if len(s.Targets) > 0 {
+		msg += " targets=" + strings.Join(s.Targets, ",")
+	}
+	auditor.Audit(&datastore.LogEnt{
+		Time: s.Time,
+		Type: datastore.AnomalyReport,
+		Src:  "scan:" + st,
+		Log:  msg,
+	})
+	log.Printf("detect %s scan src=%s dst=%s count=%d", s.Type, s.Src, s.Dst, s.Count)
+}
+
+// getNetflowScanTargets returns sorted target list limited to maxNetflowScanTargets.
+func getNetflowScanTargets(items map[string]int64) []string {
+	r := []string{}
+	for k := range items {
+		r = append(r, k)
+	}
+	sort.Strings(r)
+	if len(r) > maxNetflowScanTargets {
+		r = r[:maxNetflowScanTargets]
+	}
+	return r
+}
+
+func getNetflowScanPortRange(ports map[int]int64) string {
+	min := -1
+	max := -1
+	for p := range ports {
+		if min < 0 || p < min {
+			min = p
+		}
+		if p > max {
+			max = p
+		}
+	}
+	if min == max {
+		return fmt.Sprintf("%d", min)
+	}
+	return fmt.Sprintf("%d-%d", min, max)
+}
+
+// expireNetflowScan removes items older than scan window by flow time.
+func expireNetflowScan() {
+	if netflowScanLastTime < 1 {
+		return
+	}
+	et := netflowScanLastTime - getNetflowScanWindow()
+	for _, m := range []map[string]*netflowScanTrackEnt{netflowVScanMap, netflowHScanMap, netflowDScanMap} {
+		for k, e := range m {
+			for i, t := range e.Items {
+				if t < et {
+					delete(e.Items, i)
+				}
+			}
+			for p, t := range e.Ports {
+				if t < et {
+					delete(e.Ports, p)
+				}
+			}
+			if len(e.Items) < 1 && len(e.Ports) < 1 {
+				delete(m, k)
+			} else if e.First < et {
+				e.First = et
+			}
+		}
+	}
+}
+
+func setNetflowScanReport() {
+	sort.Slice(netflowScanList, func(i, j int) bool {
+		return netflowScanList[i].Count > netflowScanList[j].Count
+	})
+	netflowReport.Scans = len(netflowScanList)
+	if len(netflowScanList) > datastore.Config.ReportTopN {
+		netflowScanList = netflowScanList[:datastore.Config.ReportTopN]
+	}
+	netflowReport.ScanList = netflowScanList
+	netflowScanList = []datastore.NetflowScanEnt{}
+}
+
+func getNetflowScanTypes() string {
+	r := []string{}
+	if datastore.Config.NetflowScanPorts > 0 {
+		r = append(r, "vertical")
+	}
+	if datastore.Config.NetflowScanHosts > 0 {
+		r = append(r, "horizontal")
+	}
+	if datastore.Config.NetflowScanSrcs > 0 {
+		r = append(r, "distributed")
+	}
+	return strings.Join(r, ",")
+}
@@ -0,0 +1,122 @@
+package reporter
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/twsnmp/twlogeye/auditor"
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func TestNetflowScan(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.NetflowScanWindow = 60
+	datastore.Config.NetflowScanPorts = 10
+	datastore.Config.NetflowScanHosts = 10
+	datastore.Config.NetflowScanSrcs = 10
+	datastore.Config.NetflowScanSrcPorts = 10
+	datastore.Config.ReportTopN = 10
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	auditor.Init()
+	defer func() {
+		datastore.Config.NetflowScanPorts = 0
+		datastore.Config.NetflowScanHosts = 0
+		datastore.Config.NetflowScanSrcs = 0
+		datastore.Config.NetflowScanSrcPorts = 0
+	}()
+	netflowVScanMap = make(map[string]*netflowScanTrackEnt)
+	netflowHScanMap = make(map[string]*netflowScanTrackEnt)
+	netflowDScanMap = make(map[string]*netflowScanTrackEnt)
+	netflowScanList = []datastore.NetflowScanEnt{}
+	netflowScanLastTime = 0
+
+	now := time.Now().UnixNano()
+	// vertical
+	for p := 20; p < 40; p++ {
+		checkNetflowScan(now, "192.168.1.10", "192.168.1.1", 6, 40000, p)
+	}
+	// horizontal
+	for i := 0; i < 20; i++ {
+		checkNetflowScan(now, "192.168.1.11", fmt.Sprintf("192.168.2.%d", i), 6, 40001, 445)
+	}
+	// reply from server is not scan
+	for p := 40000; p < 40020; p++ {
+		checkNetflowScan(now, "192.168.1.1", "192.168.1.12", 6, 443, p)
+	}
+	// ICMP is not checked
+	for p := 0; p < 20; p++ {
+		checkNetflowScan(now, "192.168.1.13", "192.168.1.2", 1, 3, p)
+	}
+	types := make(map[string]datastore.NetflowScanEnt)
+	for _, s := range netflowScanList {
+		types[s.Type] = s
+	}
+	if len(netflowScanList) != 2 {
+		t.Fatalf("expected 2 scans, got %d %+v", len(netflowScanList), netflowScanList)
+	}
+	if s, ok := types["vertical"]; !ok {
+		t.Errorf("vertical scan not found")
+	} else if s.Src != "192.168.1.10" || s.Dst != "192.168.1.1" || s.PortRange != "20-29" {
+		t.Errorf("invalid vertical scan %+v", s)
+	}
+	if s, ok := types["horizontal"]; !ok {
+		t.Errorf("horizontal scan not found")
+	} else if s.Src != "192.168.1.11" || s.PortRange != "445" || s.Count != 10 {
+		t.Errorf("invalid horizontal scan %+v", s)
+	} else if len(s.Targets) != 10 || s.Targets[0] != "192.168.2.0" {
+		t.Errorf("invalid horizontal scan targets %+v", s.Targets)
+	}
+
+	// many sources on few ports is not distributed scan
+	for i := 0; i < 20; i++ {
+		checkNetflowScan(now, fmt.Sprintf("10.0.1.%d", i), "192.168.1.101", 17, 50000, 1000+i%5)
+	}
+	for _, s := range netflowScanList {
+		if s.Dst == "192.168.1.101" {
+			t.Errorf("unexpected distributed scan %+v", s)
+		}
+	}
+	// distributed
+	for i := 0; i < 20; i++ {
+		checkNetflowScan(now, fmt.Sprintf("10.0.0.%d", i), "192.168.1.100", 17, 50000, 1000+i)
+	}
+	found := false
+	for _, s := range netflowScanList {
+		if s.Type == "distributed" && s.Dst == "192.168.1.100" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("distributed scan not found %+v", netflowScanList)
+	}
+
+	netflowReport = &datastore.NetflowReportEnt{}
+	setNetflowScanReport()
+	if netflowReport.Scans != 3 || len(netflowReport.ScanList) != 3 {
+		t.Errorf("invalid scan report %d %d", netflowReport.Scans, len(netflowReport.ScanList))
+	}
+	if len(netflowScanList) != 0 {
+		t.Errorf("scan list not cleared")
+	}
+
+	// expire by flow time
+	datastore.Config.NetflowScanWindow = 1
+	netflowVScanMap = make(map[string]*netflowScanTrackEnt)
+	netflowScanLastTime = 0
+	old := time.Now().Add(-time.Hour).UnixNano()
+	checkNetflowScan(old, "192.168.1.20", "192.168.1.21", 6, 40000, 22)
+	expireNetflowScan()
+	if _, ok := netflowVScanMap["192.168.1.20\t192.168.1.21"]; !ok {
+		t.Errorf("scan track expired by wall clock")
+	}
+	checkNetflowScan(old+int64(time.Second*10), "192.168.1.22", "192.168.1.23", 6, 40000, 22)
+	expireNetflowScan()
+	if _, ok := netflowVScanMap["192.168.1.20\t192.168.1.21"]; ok {
+		t.Errorf("scan track not expired")
+	}
+	if _, ok := netflowVScanMap["192.168.1.22\t192.168.1.23"]; !ok {
+		t.Errorf("new scan track expired")
+	}
+}
@@ -0,0 +1,45 @@
+package reporter
+
+import (
+	"net"
+	"testing"
+
+	"github.com/twsnmp/twlogeye/datastore"
+)
+
+func TestNetflowSampling(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	netflowReport = &datastore.NetflowReportEnt{}
+	netflowMACMap = make(map[string]*netflowSummaryEnt)
+	netflowIPMap = make(map[string]*netflowSummaryEnt)
+	netflowFlowMap = make(map[string]*netflowSummaryEnt)
+	netflowProtocolMap = make(map[string]int)
+	netflowFumbleSrcMap = make(map[string]int)
+	netflowHostMap = make(map[string]int)
+	netflowZoneMap = make(map[string]*netflowSummaryEnt)
+	netflowIfMap = make(map[string]*netflowIfSummaryEnt)
+	for _, pkt := range []int{1, 10} {
+		processNetflowReport(&datastore.NetflowLogEnt{
+			Src: "192.168.1.254",
+			Log: map[string]any{
+				"srcAddr":      net.ParseIP("192.168.1.1"),
+				"srcPort":      uint16(40000),
+				"dstAddr":      net.ParseIP("192.168.1.2"),
+				"dstPort":      uint16(22),
+				"packets":      uint64(pkt),
+				"bytes":        uint64(pkt * 60),
+				"protocol":     uint8(6),
+				"samplingRate": int64(100),
+			},
+		})
+	}
+	if netflowReport.Packets != 1100 || netflowReport.Bytes != 66000 {
+		t.Errorf("invalid sampled volume packets=%d bytes=%d", netflowReport.Packets, netflowReport.Bytes)
+	}
+	// Fumble is checked by packets of sampled flow
+	if netflowFumbleSrcMap["192.168.1.1"] != 1 {
+		t.Errorf("invalid fumble %v", netflowFumbleSrcMap)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 func Init() {
 	datastore.LoadServiceMap()
+	datastore.LoadZones()
 	syslogReporterCh = make(chan *datastore.SyslogEnt, 20000)
 	trapReporterCh = make(chan *datastore.TrapLogEnt, 20000)
 	netflowReporterCh = make(chan *datastore.NetflowLogEnt, 20000)
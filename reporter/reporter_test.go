@@ -71,6 +71,7 @@ func TestNetflowReporter(t *testing.T) {
 	netflowHostMap = make(map[string]int)
 	netflowCountryMap = make(map[string]int)
 	netflowLocMap = make(map[string]int)
+	netflowZoneMap = make(map[string]*netflowSummaryEnt)
 
 	time.Sleep(100 * time.Millisecond)
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/twsnmp/twlogeye/auditor"
 	"github.com/twsnmp/twlogeye/datastore"
+	"github.com/twsnmp/twlogeye/notify"
 )
 
 var mcpAllow sync.Map
@@ -97,10 +98,38 @@ func addTools(s *mcp.Server) {
 		Name:        "search_log",
 		Description: "Search log from TwLogEye database.",
 	}, searchLog)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "get_log_stats",
+		Description: "Get count and sum,avg,min,max of numeric fields of logs per group per time bucket from TwLogEye database.",
+	}, getLogStats)
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        "search_notify",
 		Description: "Search notify from TwLogEye database.",
 	}, searchNotify)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "search_incident",
+		Description: "Search incidents (groups of repeated notify) from TwLogEye database.",
+	}, searchIncident)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "update_notify_state",
+		Description: "Update state, assignee or add comment to notify or incident of TwLogEye.",
+	}, updateNotifyState)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "get_notify_workflow",
+		Description: "Get state, assignee, comments and history of notify or incident from TwLogEye.",
+	}, getNotifyWorkflow)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "get_notify_outbox",
+		Description: "Get pending and dead letter notify deliveries from TwLogEye.",
+	}, getNotifyOutbox)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "get_notify_escalation",
+		Description: "Get pending escalations of unacknowledged notify from TwLogEye.",
+	}, getNotifyEscalation)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "redrive_notify",
+		Description: "Re-drive dead letter notify deliveries of TwLogEye.",
+	}, redriveNotify)
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        "get_report",
 		Description: "Get report from TwLogEye database.",
@@ -113,6 +142,10 @@ func addTools(s *mcp.Server) {
 		Name:        "get_anomaly_report",
 		Description: "Get anomaly report from TwLogEye database.",
 	}, getAnomalyReport)
+	mcp.AddTool(s, &mcp.Tool{
+		Name:        "get_netflow_if_report",
+		Description: "Get per-interface bandwidth time series of NetFlow exporters from TwLogEye database.",
+	}, getNetflowIfReport)
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        "get_sigma_evaluator_list",
 		Description: "Get sigma rule evaluator list from TwLogEye.",
@@ -152,6 +185,12 @@ func addPrompts(s *mcp.Server) {
 				Description: "Filter logs by regular expression. Empty is no filter.",
 				Required:    false,
 			},
+			{
+				Name:        "query",
+				Title:       "Filter logs by query.",
+				Description: "Filter logs by query like severity<=3 AND hostname:fw* AND NOT tag:cron. Empty is no filter.",
+				Required:    false,
+			},
 			{
 				Name:        "type",
 				Title:       "Type of log to search.",
@@ -319,6 +358,7 @@ type mcpLogEnt struct {
 
 type searchLogParams struct {
 	Filter string `json:"filter" jsonschema:"Filter logs by regular expression. Empty is no filter"`
+	Query  string `json:"query" jsonschema:"Filter logs by query like severity<=3 AND hostname:fw* AND NOT tag:cron. Field is key of JSON log. Operators are : = != < <= > >=. Range is severity:[0 TO 3]. Regular expression is content:/fail(ed|ure)/. src:192.168.1.0/24 is source filter. Empty is no filter"`
 	Type   string `json:"type" jsonschema:"Type of log to search. type can be syslog,trap,netflow,winevent,otel,mqtt"`
 	Start  string `json:"start" jsonschema:"Start date and time for log search. Empty is 1970/1/1. Example: 2025/10/26 11:00:00"`
 	End    string `json:"end" jsonschema:"End date and time for log search. Empty is now. Example: 2025/10/26 11:00:00"`
@@ -332,8 +372,12 @@ func searchLog(ctx context.Context, req *mcp.CallToolRequest, args searchLogPara
 		logType = "syslog"
 	}
 	filter := makeRegexFilter(args.Filter)
+	query, err := datastore.ParseLogQuery(args.Query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid query err=%v", err)
+	}
 	list := []mcpLogEnt{}
-	datastore.ForEachLog(logType, st, et, func(l *datastore.LogEnt) bool {
+	datastore.SearchLog(logType, st, et, query, func(l *datastore.LogEnt) bool {
 		if filter != nil && !filter.MatchString(l.Log) {
 			return true
 		}
@@ -356,11 +400,89 @@ func searchLog(ctx context.Context, req *mcp.CallToolRequest, args searchLogPara
 	}, nil, nil
 }
 
+type getLogStatsParams struct {
+	Query    string `json:"query" jsonschema:"Filter logs by query like tag:sshd AND failed. Empty is no filter"`
+	Type     string `json:"type" jsonschema:"Type of log. type can be syslog,trap,netflow,winevent,otel,mqtt"`
+	Start    string `json:"start" jsonschema:"Start date and time. Empty is 1970/1/1. Example: 2025/10/26 11:00:00"`
+	End      string `json:"end" jsonschema:"End date and time. Empty is now. Example: 2025/10/26 11:00:00"`
+	Group    string `json:"group" jsonschema:"Comma separated fields to group logs like hostname,tag. src is source of log. Empty is no group"`
+	Interval string `json:"interval" jsonschema:"Time bucket like 1h or 10m. Empty is whole time range"`
+	Fields   string `json:"fields" jsonschema:"Comma separated numeric fields for sum,avg,min,max like bytes,packets"`
+}
+
+type mcpLogStatsEnt struct {
+	Time  string
+	Group map[string]string `json:",omitempty"`
+	Count int64
+	Stats []*datastore.LogFieldStatsEnt `json:",omitempty"`
+}
+
+func getLogStats(ctx context.Context, req *mcp.CallToolRequest, args getLogStatsParams) (*mcp.CallToolResult, any, error) {
+	st := getTime(args.Start, 0)
+	et := getTime(args.End, time.Now().UnixNano())
+	logType := args.Type
+	if logType == "" {
+		logType = "syslog"
+	}
+	query, err := datastore.ParseLogQuery(args.Query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid query err=%v", err)
+	}
+	var interval time.Duration
+	if args.Interval != "" {
+		if interval, err = time.ParseDuration(args.Interval); err != nil {
+			return nil, nil, fmt.Errorf("invalid interval err=%v", err)
+		}
+	}
+	group := splitMCPList(args.Group)
+	r, err := datastore.GetLogStats(logType, st, et, query, group, interval, splitMCPList(args.Fields))
+	if err != nil {
+		return nil, nil, err
+	}
+	list := []mcpLogStatsEnt{}
+	for _, e := range r {
+		s := mcpLogStatsEnt{
+			Time:  time.Unix(0, e.Time).Format(time.RFC3339Nano),
+			Count: e.Count,
+			Stats: e.Stats,
+		}
+		if len(group) > 0 {
+			s.Group = make(map[string]string)
+			for i, g := range group {
+				s.Group[g] = e.Group[i]
+			}
+		}
+		list = append(list, s)
+	}
+	j, err := json.Marshal(&list)
+	if err != nil {
+		j = []byte(err.Error())
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(j)},
+		},
+	}, nil, nil
+}
+
+func splitMCPList(s string) []string {
+	ret := []string{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
 func searchLogPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
 	c := []string{}
 	if filter, ok := req.Params.Arguments["filter"]; ok {
 		c = append(c, fmt.Sprintf("- Filter: %s", filter))
 	}
+	if query, ok := req.Params.Arguments["query"]; ok {
+		c = append(c, fmt.Sprintf("- Query: %s", query))
+	}
 	if limit, ok := req.Params.Arguments["limit"]; ok {
 		c = append(c, fmt.Sprintf("- Limit: %s", limit))
 	}
@@ -388,19 +510,53 @@ func searchLogPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPr
 }
 
 type mcpNotifyEnt struct {
-	Time  string
-	Type  string
-	Log   string
-	Src   string
-	ID    string
-	Title string
-	Tags  string
-	Level string
+	Time     string
+	Type     string
+	Log      string
+	Src      string
+	ID       string
+	Title    string
+	Tags     string
+	Level    string
+	Target   string
+	State    string
+	Assignee string                 `json:",omitempty"`
+	Delivery []mcpNotifyDeliveryEnt `json:",omitempty"`
+	Actions  []mcpNotifyActionEnt   `json:",omitempty"`
+	// Incident
+	IncidentID string `json:",omitempty"`
+	Count      int    `json:",omitempty"`
+	FirstSeen  string `json:",omitempty"`
+	LastSeen   string `json:",omitempty"`
+}
+
+type mcpNotifyActionEnt struct {
+	Name     string
+	Type     string
+	Status   string
+	Code     int
+	Output   string
+	Error    string
+	Time     string
+	Duration int64
 }
+
+type mcpNotifyDeliveryEnt struct {
+	Dst      string
+	Status   string
+	Attempts int
+	Time     string
+	Error    string
+	// Escalation step (0=first notification)
+	Escalation int `json:",omitempty"`
+}
+
 type searchNotifyParams struct {
-	Level string `json:"level" jsonschema:"Regular expression-based notify level filter. level name is info,low,high,medium,critical empty is no filter."`
-	Start string `json:"start" jsonschema:"Start date and time for notify search. Empty is 1970/1/1. Example: 2025/10/26 11:00:00"`
-	End   string `json:"end" jsonschema:"End date and time for notify search. Empty is now. Example: 2025/10/26 11:00:00"`
+	Level    string `json:"level" jsonschema:"Regular expression-based notify level filter. level name is info,low,high,medium,critical empty is no filter."`
+	Start    string `json:"start" jsonschema:"Start date and time for notify search. Empty is 1970/1/1. Example: 2025/10/26 11:00:00"`
+	End      string `json:"end" jsonschema:"End date and time for notify search. Empty is now. Example: 2025/10/26 11:00:00"`
+	State    string `json:"state" jsonschema:"State filter. state is new,acknowledged,investigating,resolved,false-positive or open (not resolved). Empty is no filter."`
+	Assignee string `json:"assignee" jsonschema:"Assignee filter. Empty is no filter."`
 }
 
 func searchNotify(ctx context.Context, req *mcp.CallToolRequest, args searchNotifyParams) (*mcp.CallToolResult, any, error) {
@@ -412,15 +568,284 @@ func searchNotify(ctx context.Context, req *mcp.CallToolRequest, args searchNoti
 		if level != nil && !level.MatchString(n.Level) {
 			return true
 		}
-		list = append(list, mcpNotifyEnt{
-			Time:  time.Unix(0, n.Time).Format(time.RFC3339Nano),
-			Type:  n.Type.String(),
-			Src:   n.Src,
-			Log:   n.Log,
-			ID:    n.ID,
-			Title: n.Title,
-			Tags:  n.Tags,
-			Level: n.Level,
+		w := datastore.GetNotifyWorkflow(datastore.GetNotifyWorkflowTarget(n))
+		if !matchNotifyWorkflow(w, args.State, args.Assignee) {
+			return true
+		}
+		e := mcpNotifyEnt{
+			Time:       time.Unix(0, n.Time).Format(time.RFC3339Nano),
+			Type:       n.Type.String(),
+			Src:        n.Src,
+			Log:        n.Log,
+			ID:         n.ID,
+			Title:      n.Title,
+			Tags:       n.Tags,
+			Level:      n.Level,
+			Target:     w.Target,
+			State:      w.State,
+			Assignee:   w.Assignee,
+			Delivery:   getMCPNotifyDeliveryList(n),
+			Actions:    getMCPNotifyActionList(n),
+			IncidentID: n.IncidentID,
+			Count:      n.Count,
+		}
+		if n.FirstSeen > 0 {
+			e.FirstSeen = time.Unix(0, n.FirstSeen).Format(time.RFC3339Nano)
+			e.LastSeen = time.Unix(0, n.LastSeen).Format(time.RFC3339Nano)
+		}
+		list = append(list, e)
+		return true
+	})
+	j, err := json.Marshal(&list)
+	if err != nil {
+		j = []byte(err.Error())
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(j)},
+		},
+	}, nil, nil
+}
+
+type updateNotifyStateParams struct {
+	Target   string `json:"target" jsonschema:"Target of notify or incident. Target is in result of search_notify or search_incident."`
+	State    string `json:"state" jsonschema:"New state. state is new,acknowledged,investigating,resolved,false-positive. Empty is no change."`
+	Assignee string `json:"assignee" jsonschema:"New assignee. Empty is no change. - is unassign."`
+	Comment  string `json:"comment" jsonschema:"Comment to add. Empty is no comment."`
+	User     string `json:"user" jsonschema:"User name who updates. Empty is mcp."`
+}
+
+func updateNotifyState(ctx context.Context, req *mcp.CallToolRequest, args updateNotifyStateParams) (*mcp.CallToolResult, any, error) {
+	user := args.User
+	if user == "" {
+		user = "mcp"
+	}
+	w, err := datastore.UpdateNotifyWorkflow(args.Target, user, args.State, args.Assignee, args.Comment)
+	if err != nil {
+		return nil, nil, err
+	}
+	return makeMCPNotifyWorkflowResult(w)
+}
+
+type getNotifyWorkflowParams struct {
+	Target string `json:"target" jsonschema:"Target of notify or incident. Target is in result of search_notify or search_incident."`
+}
+
+func getNotifyWorkflow(ctx context.Context, req *mcp.CallToolRequest, args getNotifyWorkflowParams) (*mcp.CallToolResult, any, error) {
+	return makeMCPNotifyWorkflowResult(datastore.GetNotifyWorkflow(args.Target))
+}
+
+type mcpNotifyHistoryEnt struct {
+	Time     string
+	User     string
+	State    string `json:",omitempty"`
+	Assignee string `json:",omitempty"`
+	Comment  string `json:",omitempty"`
+}
+
+type mcpNotifyWorkflowEnt struct {
+	Target   string
+	State    string
+	Assignee string
+	History  []mcpNotifyHistoryEnt
+}
+
+func makeMCPNotifyWorkflowResult(w *datastore.NotifyWorkflowEnt) (*mcp.CallToolResult, any, error) {
+	r := mcpNotifyWorkflowEnt{
+		Target:   w.Target,
+		State:    w.State,
+		Assignee: w.Assignee,
+		History:  []mcpNotifyHistoryEnt{},
+	}
+	for _, h := range w.History {
+		r.History = append(r.History, mcpNotifyHistoryEnt{
+			Time:     time.Unix(0, h.Time).Format(time.RFC3339Nano),
+			User:     h.User,
+			State:    h.State,
+			Assignee: h.Assignee,
+			Comment:  h.Comment,
+		})
+	}
+	j, err := json.Marshal(&r)
+	if err != nil {
+		j = []byte(err.Error())
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(j)},
+		},
+	}, nil, nil
+}
+
+type mcpIncidentEnt struct {
+	ID        string
+	RuleID    string
+	Level     string
+	Title     string
+	Tags      string
+	Src       string
+	Log       string
+	Count     int
+	FirstSeen string
+	LastSeen  string
+	Closed    bool
+	Target    string
+	State     string
+	Assignee  string `json:",omitempty"`
+}
+
+func searchIncident(ctx context.Context, req *mcp.CallToolRequest, args searchNotifyParams) (*mcp.CallToolResult, any, error) {
+	st := getTime(args.Start, 0)
+	et := getTime(args.End, time.Now().UnixNano())
+	level := makeRegexFilter(args.Level)
+	list := []mcpIncidentEnt{}
+	datastore.ForEachIncident(st, et, func(i *datastore.IncidentEnt) bool {
+		if level != nil && !level.MatchString(i.Notify.Level) {
+			return true
+		}
+		w := datastore.GetNotifyWorkflow("incident:" + i.ID)
+		if !matchNotifyWorkflow(w, args.State, args.Assignee) {
+			return true
+		}
+		list = append(list, mcpIncidentEnt{
+			ID:        i.ID,
+			RuleID:    i.Notify.ID,
+			Level:     i.Notify.Level,
+			Title:     i.Notify.Title,
+			Tags:      i.Notify.Tags,
+			Src:       i.Notify.Src,
+			Log:       i.Notify.Log,
+			Count:     i.Count,
+			FirstSeen: time.Unix(0, i.FirstSeen).Format(time.RFC3339Nano),
+			LastSeen:  time.Unix(0, i.LastSeen).Format(time.RFC3339Nano),
+			Closed:    i.Closed,
+			Target:    w.Target,
+			State:     w.State,
+			Assignee:  w.Assignee,
+		})
+		return true
+	})
+	j, err := json.Marshal(&list)
+	if err != nil {
+		j = []byte(err.Error())
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(j)},
+		},
+	}, nil, nil
+}
+
+func getMCPNotifyActionList(n *datastore.NotifyEnt) []mcpNotifyActionEnt {
+	ret := []mcpNotifyActionEnt{}
+	for _, a := range n.Actions {
+		ret = append(ret, mcpNotifyActionEnt{
+			Name:     a.Name,
+			Type:     a.Type,
+			Status:   a.Status,
+			Code:     a.Code,
+			Output:   a.Output,
+			Error:    a.Error,
+			Time:     time.Unix(0, a.Time).Format(time.RFC3339Nano),
+			Duration: a.Duration,
+		})
+	}
+	return ret
+}
+
+func getMCPNotifyDeliveryList(n *datastore.NotifyEnt) []mcpNotifyDeliveryEnt {
+	ret := []mcpNotifyDeliveryEnt{}
+	for _, d := range n.Delivery {
+		ret = append(ret, mcpNotifyDeliveryEnt{
+			Dst:        d.Dst,
+			Status:     d.Status,
+			Attempts:   d.Attempts,
+			Time:       time.Unix(0, d.Time).Format(time.RFC3339Nano),
+			Error:      d.Error,
+			Escalation: d.Escalation,
+		})
+	}
+	return ret
+}
+
+type mcpNotifyOutboxEnt struct {
+	Key        string
+	Dst        string
+	NotifyTime string
+	ID         string
+	Level      string
+	Title      string
+	Src        string
+	Created    string
+	Attempts   int
+	NextTry    string
+	LastError  string
+	DeadLetter bool
+}
+
+func getNotifyOutbox(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, any, error) {
+	list := []mcpNotifyOutboxEnt{}
+	add := func(o *datastore.OutboxEnt, dead bool) bool {
+		list = append(list, mcpNotifyOutboxEnt{
+			Key:        o.Key,
+			Dst:        o.Dst,
+			NotifyTime: time.Unix(0, o.Notify.Time).Format(time.RFC3339Nano),
+			ID:         o.Notify.ID,
+			Level:      o.Notify.Level,
+			Title:      o.Notify.Title,
+			Src:        o.Notify.Src,
+			Created:    time.Unix(0, o.Created).Format(time.RFC3339Nano),
+			Attempts:   o.Attempts,
+			NextTry:    time.Unix(0, o.NextTry).Format(time.RFC3339Nano),
+			LastError:  o.LastError,
+			DeadLetter: dead,
+		})
+		return true
+	}
+	datastore.ForEachOutbox(func(o *datastore.OutboxEnt) bool {
+		return add(o, false)
+	})
+	datastore.ForEachDeadLetter(func(o *datastore.OutboxEnt) bool {
+		return add(o, true)
+	})
+	j, err := json.Marshal(&list)
+	if err != nil {
+		j = []byte(err.Error())
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(j)},
+		},
+	}, nil, nil
+}
+
+type mcpNotifyEscalationEnt struct {
+	Target  string
+	Policy  string
+	Step    int
+	Next    string
+	Created string
+	ID      string
+	Level   string
+	Title   string
+	Src     string
+	Dst     []string
+}
+
+func getNotifyEscalation(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, any, error) {
+	list := []mcpNotifyEscalationEnt{}
+	datastore.ForEachEscalation(func(e *datastore.EscalationEnt) bool {
+		list = append(list, mcpNotifyEscalationEnt{
+			Target:  e.Target,
+			Policy:  e.Policy,
+			Step:    e.Step + 1,
+			Next:    time.Unix(0, e.Next).Format(time.RFC3339Nano),
+			Created: time.Unix(0, e.Created).Format(time.RFC3339Nano),
+			ID:      e.Notify.ID,
+			Level:   e.Notify.Level,
+			Title:   e.Notify.Title,
+			Src:     e.Notify.Src,
+			Dst:     e.Dst,
 		})
 		return true
 	})
@@ -435,6 +860,22 @@ func searchNotify(ctx context.Context, req *mcp.CallToolRequest, args searchNoti
 	}, nil, nil
 }
 
+type redriveNotifyParams struct {
+	Key string `json:"key" jsonschema:"Key of dead letter to re-drive. Empty is all dead letters."`
+}
+
+func redriveNotify(ctx context.Context, req *mcp.CallToolRequest, args redriveNotifyParams) (*mcp.CallToolResult, any, error) {
+	c := datastore.RedriveDeadLetter(args.Key)
+	if c > 0 {
+		notify.Retry()
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("redrive %d notify", c)},
+		},
+	}, nil, nil
+}
+
 func searchNotifyPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
 	c := []string{}
 	if level, ok := req.Params.Arguments["level"]; ok {
@@ -688,6 +1129,12 @@ type mcpNetflowReportEnt struct {
 	TopFlowBytesList   []datastore.NetflowBytesSummaryEnt
 	TopProtocolList    []datastore.NetflowKeyCountEnt
 	TopFumbleSrcList   []datastore.NetflowKeyCountEnt
+	Scans              int
+	ScanList           []datastore.NetflowScanEnt
+	Beacons            int
+	BeaconList         []datastore.NetflowBeaconEnt
+	ZoneMatrix         []datastore.NetflowZoneEnt
+	IfList             []datastore.NetflowIfEnt
 }
 
 func getNetflowReport(st, et int64) string {
@@ -711,6 +1158,12 @@ func getNetflowReport(st, et int64) string {
 				TopFlowBytesList:   r.TopFlowBytesList,
 				TopProtocolList:    r.TopProtocolList,
 				TopFumbleSrcList:   r.TopFumbleSrcList,
+				Scans:              r.Scans,
+				ScanList:           r.ScanList,
+				Beacons:            r.Beacons,
+				BeaconList:         r.BeaconList,
+				ZoneMatrix:         r.ZoneMatrix,
+				IfList:             r.IfList,
 			})
 		return true
 	})
@@ -743,6 +1196,12 @@ func getLastNetflowReport() string {
 		TopFlowBytesList:   l.TopFlowBytesList,
 		TopProtocolList:    l.TopProtocolList,
 		TopFumbleSrcList:   l.TopFumbleSrcList,
+		Scans:              l.Scans,
+		ScanList:           l.ScanList,
+		Beacons:            l.Beacons,
+		BeaconList:         l.BeaconList,
+		ZoneMatrix:         l.ZoneMatrix,
+		IfList:             l.IfList,
 	}
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -751,6 +1210,66 @@ func getLastNetflowReport() string {
 	return string(j)
 }
 
+type getNetflowIfReportParams struct {
+	Exporter  string `json:"exporter" jsonschema:"IP address of NetFlow exporter. Empty is all exporters."`
+	Interface string `json:"interface" jsonschema:"ifIndex or ifName of interface. Empty is all interfaces."`
+	Start     string `json:"start" jsonschema:"Start date and time to get report. Empty is 1970/1/1. Example: 2025/10/26 11:00:00"`
+	End       string `json:"end" jsonschema:"End date and time to get report. Empty is now. Example: 2025/10/26 11:00:00"`
+}
+
+type mcpNetflowIfEnt struct {
+	Time          string
+	Exporter      string
+	IfIndex       int
+	Name          string
+	InBps         float64
+	OutBps        float64
+	InPps         float64
+	OutPps        float64
+	InUtil        float64
+	OutUtil       float64
+	TopTalkerList []datastore.NetflowBytesSummaryEnt
+}
+
+func getNetflowIfReport(ctx context.Context, req *mcp.CallToolRequest, args getNetflowIfReportParams) (*mcp.CallToolResult, any, error) {
+	st := getTime(args.Start, 0)
+	et := getTime(args.End, time.Now().UnixNano())
+	list := []mcpNetflowIfEnt{}
+	datastore.ForEachNetflowReport(st, et, func(r *datastore.NetflowReportEnt) bool {
+		for _, e := range r.IfList {
+			if args.Exporter != "" && args.Exporter != e.Exporter {
+				continue
+			}
+			if args.Interface != "" && args.Interface != e.Name && args.Interface != fmt.Sprintf("%d", e.IfIndex) {
+				continue
+			}
+			list = append(list, mcpNetflowIfEnt{
+				Time:          time.Unix(0, r.Time).Format(time.RFC3339),
+				Exporter:      e.Exporter,
+				IfIndex:       e.IfIndex,
+				Name:          e.Name,
+				InBps:         e.InBps,
+				OutBps:        e.OutBps,
+				InPps:         e.InPps,
+				OutPps:        e.OutPps,
+				InUtil:        e.InUtil,
+				OutUtil:       e.OutUtil,
+				TopTalkerList: e.TopTalkerList,
+			})
+		}
+		return true
+	})
+	j, err := json.Marshal(&list)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(j)},
+		},
+	}, nil, nil
+}
+
 type mcpWindowsEventReportEnt struct {
 	Time         string
 	Normal       int
@@ -1035,15 +1554,69 @@ func getLastAnomalyReport() string {
 }
 
 type mcpMonitorReportEnt struct {
-	Time    string
-	CPU     float64
-	Memory  float64
-	Load    float64
-	Disk    float64
-	Net     float64
-	Bytes   int64
-	DBSpeed float64
-	DBSize  int64
+	Time     string
+	CPU      float64
+	Memory   float64
+	Load     float64
+	Disk     float64
+	Net      float64
+	Bytes    int64
+	DBSpeed  float64
+	DBSize   int64
+	Usage    []mcpDBUsageEnt `json:",omitempty"`
+	LSMSize  int64
+	VlogSize int64
+	LastGC   *mcpDBMaintenanceEnt `json:",omitempty"`
+}
+
+type mcpDBMaintenanceEnt struct {
+	Time     string
+	Duration string
+	Flatten  bool
+	GCCount  int64
+	Before   int64
+	After    int64
+	Error    string `json:",omitempty"`
+}
+
+func getMCPDBMaintenance(r *datastore.DBMaintenanceEnt) *mcpDBMaintenanceEnt {
+	if r == nil {
+		return nil
+	}
+	return &mcpDBMaintenanceEnt{
+		Time:     time.Unix(0, r.Time).Format(time.RFC3339),
+		Duration: time.Duration(r.Duration).String(),
+		Flatten:  r.Flatten,
+		GCCount:  r.GCCount,
+		Before:   r.Before,
+		After:    r.After,
+		Error:    r.Error,
+	}
+}
+
+type mcpDBUsageEnt struct {
+	Type   string
+	Keys   int64
+	Size   int64
+	Oldest string `json:",omitempty"`
+	Newest string `json:",omitempty"`
+}
+
+func getMCPDBUsageList(list []*datastore.DBUsageEnt) []mcpDBUsageEnt {
+	ret := []mcpDBUsageEnt{}
+	for _, u := range list {
+		e := mcpDBUsageEnt{
+			Type: u.Type,
+			Keys: u.Keys,
+			Size: u.Size,
+		}
+		if u.Oldest > 0 {
+			e.Oldest = time.Unix(0, u.Oldest).Format(time.RFC3339)
+			e.Newest = time.Unix(0, u.Newest).Format(time.RFC3339)
+		}
+		ret = append(ret, e)
+	}
+	return ret
 }
 
 func getMonitorReport(st, et int64) string {
@@ -1051,15 +1624,19 @@ func getMonitorReport(st, et int64) string {
 	datastore.ForEachMonitorReport(st, et, func(r *datastore.MonitorReportEnt) bool {
 		list = append(list,
 			mcpMonitorReportEnt{
-				Time:    time.Unix(0, r.Time).Format(time.RFC3339),
-				CPU:     r.CPU,
-				Memory:  r.Memory,
-				Load:    r.Load,
-				Disk:    r.Disk,
-				Net:     r.Net,
-				Bytes:   r.Bytes,
-				DBSpeed: r.DBSpeed,
-				DBSize:  r.DBSize,
+				Time:     time.Unix(0, r.Time).Format(time.RFC3339),
+				CPU:      r.CPU,
+				Memory:   r.Memory,
+				Load:     r.Load,
+				Disk:     r.Disk,
+				Net:      r.Net,
+				Bytes:    r.Bytes,
+				DBSpeed:  r.DBSpeed,
+				DBSize:   r.DBSize,
+				Usage:    getMCPDBUsageList(r.Usage),
+				LSMSize:  r.LSMSize,
+				VlogSize: r.VlogSize,
+				LastGC:   getMCPDBMaintenance(r.LastGC),
 			})
 		return true
 	})
@@ -1076,15 +1653,19 @@ func getLastMonitorReport() string {
 		return "monitor report not found"
 	}
 	r := &mcpMonitorReportEnt{
-		Time:    time.Unix(0, l.Time).Format(time.RFC3339),
-		CPU:     l.CPU,
-		Memory:  l.Memory,
-		Load:    l.Load,
-		Disk:    l.Disk,
-		Net:     l.Net,
-		Bytes:   l.Bytes,
-		DBSpeed: l.DBSpeed,
-		DBSize:  l.DBSize,
+		Time:     time.Unix(0, l.Time).Format(time.RFC3339),
+		CPU:      l.CPU,
+		Memory:   l.Memory,
+		Load:     l.Load,
+		Disk:     l.Disk,
+		Net:      l.Net,
+		Bytes:    l.Bytes,
+		DBSpeed:  l.DBSpeed,
+		DBSize:   l.DBSize,
+		Usage:    getMCPDBUsageList(l.Usage),
+		LSMSize:  l.LSMSize,
+		VlogSize: l.VlogSize,
+		LastGC:   getMCPDBMaintenance(l.LastGC),
 	}
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1,9 +1,11 @@
 package server
 
 import (
+	"bufio"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net"
@@ -16,6 +18,7 @@ import (
 	"github.com/twsnmp/twlogeye/api"
 	"github.com/twsnmp/twlogeye/auditor"
 	"github.com/twsnmp/twlogeye/datastore"
+	"github.com/twsnmp/twlogeye/notify"
 	"github.com/twsnmp/twlogeye/reporter"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -124,7 +127,9 @@ func (s *apiServer) ClearDB(ctx context.Context, req *api.ClearRequest) (*api.Co
 	sub := req.GetSubtype()
 	switch t {
 	case "logs":
-		datastore.ClearLog(sub)
+		if err := datastore.ClearLog(sub); err != nil {
+			return nil, err
+		}
 	case "notify":
 		datastore.ClearNotify()
 	case "otel":
@@ -142,20 +147,134 @@ func (s *apiServer) ClearDB(ctx context.Context, req *api.ClearRequest) (*api.Co
 	}, nil
 }
 
+func (s *apiServer) GetLogStats(req *api.LogStatsRequest, stream api.TWLogEyeService_GetLogStatsServer) error {
+	query, err := datastore.ParseLogQuery(req.GetQuery())
+	if err != nil {
+		return fmt.Errorf("invalid query err=%v", err)
+	}
+	list, err := datastore.GetLogStats(req.GetLogtype(), req.GetStart(), req.GetEnd(), query,
+		req.GetGroupBy(), time.Duration(req.GetInterval())*time.Second, req.GetFields())
+	if err != nil {
+		return err
+	}
+	for _, e := range list {
+		r := &api.LogStatsEnt{
+			Time:  e.Time,
+			Group: e.Group,
+			Count: e.Count,
+		}
+		for _, f := range e.Stats {
+			r.Stats = append(r.Stats, &api.LogFieldStatsEnt{
+				Field: f.Field,
+				Count: f.Count,
+				Sum:   f.Sum,
+				Avg:   f.Avg,
+				Min:   f.Min,
+				Max:   f.Max,
+			})
+		}
+		if err := stream.Send(r); err != nil {
+			log.Printf("get log stats err=%v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *apiServer) RebuildLogIndex(ctx context.Context, req *api.LogRequest) (*api.ControlResponse, error) {
+	st := time.Now()
+	t := req.GetLogtype()
+	count, err := datastore.RebuildLogIndex(t)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("rebuild log index %s count=%d dur=%v", t, count, time.Since(st))
+	return &api.ControlResponse{
+		Ok:      true,
+		Message: fmt.Sprintf("twlogeye rebuild log index %s count=%d", t, count),
+	}, nil
+}
+
+func (s *apiServer) GetArchiveList(req *api.LogRequest, stream api.TWLogEyeService_GetArchiveListServer) error {
+	list, err := datastore.GetArchiveList(req.GetLogtype(), req.GetStart(), req.GetEnd())
+	if err != nil {
+		return err
+	}
+	for _, e := range list {
+		if err := stream.Send(&api.ArchiveEnt{
+			Type:  e.Type,
+			Start: e.Start,
+			End:   e.End,
+			File:  e.File,
+			Count: e.Count,
+			Size:  e.Size,
+			Proof: e.Proof,
+		}); err != nil {
+			log.Printf("get archive list err=%v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *apiServer) ImportArchive(ctx context.Context, req *api.LogRequest) (*api.ControlResponse, error) {
+	st := time.Now()
+	t := req.GetLogtype()
+	count, err := datastore.ImportArchive(t, req.GetStart(), req.GetEnd())
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("import archive %s count=%d dur=%v", t, count, time.Since(st))
+	return &api.ControlResponse{
+		Ok:      true,
+		Message: fmt.Sprintf("twlogeye import archive %s count=%d", t, count),
+	}, nil
+}
+
+func (s *apiServer) VerifyLog(req *api.LogRequest, stream api.TWLogEyeService_VerifyLogServer) error {
+	types := []string{req.GetLogtype()}
+	if req.GetLogtype() == "" || req.GetLogtype() == "all" {
+		types = []string{"syslog", "trap", "netflow", "windows", "otel", "mqtt"}
+	}
+	for _, t := range types {
+		r, err := datastore.VerifyLog(t, req.GetStart(), req.GetEnd())
+		if err != nil {
+			return err
+		}
+		if len(r.Issues) > 0 {
+			log.Printf("verify log %s issues=%d", t, len(r.Issues))
+		}
+		v := &api.LogVerifyEnt{
+			Type:        r.Type,
+			Batches:     r.Batches,
+			Logs:        r.Logs,
+			Expired:     r.Expired,
+			Checkpoints: r.Checkpoints,
+			FirstSeq:    r.FirstSeq,
+			LastSeq:     r.LastSeq,
+		}
+		for _, i := range r.Issues {
+			v.Issues = append(v.Issues, &api.LogVerifyIssue{
+				Kind:    i.Kind,
+				Seq:     i.Seq,
+				Time:    i.Time,
+				Message: i.Message,
+			})
+		}
+		if err := stream.Send(v); err != nil {
+			log.Printf("verify log err=%v", err)
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *apiServer) WatchNotify(req *api.Empty, stream api.TWLogEyeService_WatchNotifyServer) error {
 	id := fmt.Sprintf("%16x", time.Now().UnixNano())
 	ch := auditor.AddWatch(id)
 	defer auditor.DelWatch(id)
 	for n := range ch {
-		if err := stream.Send(&api.NotifyResponse{
-			Time:  n.Time,
-			Id:    n.ID,
-			Title: n.Title,
-			Tags:  n.Tags,
-			Log:   n.Log,
-			Level: n.Level,
-			Src:   n.Src,
-		}); err != nil {
+		if err := stream.Send(makeNotifyResponse(n, datastore.GetNotifyWorkflow(datastore.GetNotifyWorkflowTarget(n)))); err != nil {
 			log.Printf("watch notify err=%v", err)
 			break
 		}
@@ -170,15 +289,11 @@ func (s *apiServer) SearchNotify(req *api.NofifyRequest, stream api.TWLogEyeServ
 			//Skip
 			return true
 		}
-		if err := stream.Send(&api.NotifyResponse{
-			Time:  n.Time,
-			Id:    n.ID,
-			Title: n.Title,
-			Tags:  n.Tags,
-			Log:   n.Log,
-			Level: n.Level,
-			Src:   n.Src,
-		}); err != nil {
+		w := datastore.GetNotifyWorkflow(datastore.GetNotifyWorkflowTarget(n))
+		if !matchNotifyWorkflow(w, req.GetState(), req.GetAssignee()) {
+			return true
+		}
+		if err := stream.Send(makeNotifyResponse(n, w)); err != nil {
 			log.Printf("search notify err=%v", err)
 			return false
 		}
@@ -187,9 +302,222 @@ func (s *apiServer) SearchNotify(req *api.NofifyRequest, stream api.TWLogEyeServ
 	return nil
 }
 
+func (s *apiServer) SearchIncident(req *api.NofifyRequest, stream api.TWLogEyeService_SearchIncidentServer) error {
+	level := req.GetLevel()
+	datastore.ForEachIncident(req.GetStart(), req.GetEnd(), func(i *datastore.IncidentEnt) bool {
+		if level != "" && level != i.Notify.Level {
+			return true
+		}
+		w := datastore.GetNotifyWorkflow("incident:" + i.ID)
+		if !matchNotifyWorkflow(w, req.GetState(), req.GetAssignee()) {
+			return true
+		}
+		if err := stream.Send(&api.IncidentEnt{
+			Id:        i.ID,
+			RuleId:    i.Notify.ID,
+			Level:     i.Notify.Level,
+			Title:     i.Notify.Title,
+			Tags:      i.Notify.Tags,
+			Src:       i.Notify.Src,
+			Log:       i.Notify.Log,
+			Count:     int32(i.Count),
+			FirstSeen: i.FirstSeen,
+			LastSeen:  i.LastSeen,
+			Closed:    i.Closed,
+			State:     w.State,
+			Assignee:  w.Assignee,
+		}); err != nil {
+			log.Printf("search incident err=%v", err)
+			return false
+		}
+		return true
+	})
+	return nil
+}
+
+func makeNotifyResponse(n *datastore.NotifyEnt, w *datastore.NotifyWorkflowEnt) *api.NotifyResponse {
+	return &api.NotifyResponse{
+		Time:       n.Time,
+		Id:         n.ID,
+		Title:      n.Title,
+		Tags:       n.Tags,
+		Log:        n.Log,
+		Level:      n.Level,
+		Src:        n.Src,
+		Delivery:   getNotifyDeliveryList(n),
+		Actions:    getNotifyActionList(n),
+		IncidentId: n.IncidentID,
+		Count:      int32(n.Count),
+		FirstSeen:  n.FirstSeen,
+		LastSeen:   n.LastSeen,
+		Target:     w.Target,
+		State:      w.State,
+		Assignee:   w.Assignee,
+	}
+}
+
+// matchNotifyWorkflow checks state and assignee filter. State "open" matches states needing action.
+func matchNotifyWorkflow(w *datastore.NotifyWorkflowEnt, state, assignee string) bool {
+	switch state {
+	case "":
+	case "open":
+		if !datastore.IsOpenNotifyState(w.State) {
+			return false
+		}
+	default:
+		if state != w.State {
+			return false
+		}
+	}
+	return assignee == "" || assignee == w.Assignee
+}
+
+func (s *apiServer) UpdateNotifyState(ctx context.Context, req *api.NotifyStateRequest) (*api.NotifyWorkflowEnt, error) {
+	w, err := datastore.UpdateNotifyWorkflow(req.GetTarget(), req.GetUser(), req.GetState(), req.GetAssignee(), req.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("update notify %s state=%s assignee=%s user=%s", w.Target, w.State, w.Assignee, req.GetUser())
+	return makeNotifyWorkflowEnt(w), nil
+}
+
+func (s *apiServer) GetNotifyWorkflow(ctx context.Context, req *api.IDRequest) (*api.NotifyWorkflowEnt, error) {
+	return makeNotifyWorkflowEnt(datastore.GetNotifyWorkflow(req.GetId())), nil
+}
+
+func makeNotifyWorkflowEnt(w *datastore.NotifyWorkflowEnt) *api.NotifyWorkflowEnt {
+	ret := &api.NotifyWorkflowEnt{
+		Target:   w.Target,
+		State:    w.State,
+		Assignee: w.Assignee,
+		Updated:  w.Updated,
+	}
+	for _, c := range w.Comments {
+		ret.Comments = append(ret.Comments, &api.NotifyCommentEnt{
+			Time: c.Time,
+			User: c.User,
+			Text: c.Text,
+		})
+	}
+	for _, h := range w.History {
+		ret.History = append(ret.History, &api.NotifyHistoryEnt{
+			Time:     h.Time,
+			User:     h.User,
+			State:    h.State,
+			Assignee: h.Assignee,
+			Comment:  h.Comment,
+		})
+	}
+	return ret
+}
+
+func getNotifyActionList(n *datastore.NotifyEnt) []*api.NotifyActionEnt {
+	ret := []*api.NotifyActionEnt{}
+	for _, a := range n.Actions {
+		ret = append(ret, &api.NotifyActionEnt{
+			Name:     a.Name,
+			Type:     a.Type,
+			Status:   a.Status,
+			Code:     int32(a.Code),
+			Output:   a.Output,
+			Error:    a.Error,
+			Time:     a.Time,
+			Duration: a.Duration,
+		})
+	}
+	return ret
+}
+
+func getNotifyDeliveryList(n *datastore.NotifyEnt) []*api.NotifyDeliveryEnt {
+	ret := []*api.NotifyDeliveryEnt{}
+	for _, d := range n.Delivery {
+		ret = append(ret, &api.NotifyDeliveryEnt{
+			Dst:        d.Dst,
+			Status:     d.Status,
+			Attempts:   int32(d.Attempts),
+			Time:       d.Time,
+			Error:      d.Error,
+			Escalation: int32(d.Escalation),
+		})
+	}
+	return ret
+}
+
+func (s *apiServer) GetNotifyOutbox(req *api.Empty, stream api.TWLogEyeService_GetNotifyOutboxServer) error {
+	var err error
+	send := func(o *datastore.OutboxEnt, dead bool) bool {
+		err = stream.Send(&api.NotifyOutboxEnt{
+			Key:        o.Key,
+			Dst:        o.Dst,
+			NotifyTime: o.Notify.Time,
+			Id:         o.Notify.ID,
+			Level:      o.Notify.Level,
+			Title:      o.Notify.Title,
+			Src:        o.Notify.Src,
+			Created:    o.Created,
+			Attempts:   int32(o.Attempts),
+			LastTry:    o.LastTry,
+			NextTry:    o.NextTry,
+			LastError:  o.LastError,
+			Dead:       dead,
+		})
+		return err == nil
+	}
+	datastore.ForEachOutbox(func(o *datastore.OutboxEnt) bool {
+		return send(o, false)
+	})
+	if err == nil {
+		datastore.ForEachDeadLetter(func(o *datastore.OutboxEnt) bool {
+			return send(o, true)
+		})
+	}
+	if err != nil {
+		log.Printf("get notify outbox err=%v", err)
+	}
+	return nil
+}
+
+func (s *apiServer) GetNotifyEscalation(req *api.Empty, stream api.TWLogEyeService_GetNotifyEscalationServer) error {
+	datastore.ForEachEscalation(func(e *datastore.EscalationEnt) bool {
+		if err := stream.Send(&api.NotifyEscalationEnt{
+			Target:  e.Target,
+			Policy:  e.Policy,
+			Step:    int32(e.Step + 1),
+			Next:    e.Next,
+			Created: e.Created,
+			RuleId:  e.Notify.ID,
+			Level:   e.Notify.Level,
+			Title:   e.Notify.Title,
+			Src:     e.Notify.Src,
+			Dst:     e.Dst,
+		}); err != nil {
+			log.Printf("get notify escalation err=%v", err)
+			return false
+		}
+		return true
+	})
+	return nil
+}
+
+func (s *apiServer) RedriveNotify(ctx context.Context, req *api.IDRequest) (*api.ControlResponse, error) {
+	c := datastore.RedriveDeadLetter(req.GetId())
+	if c > 0 {
+		notify.Retry()
+	}
+	log.Printf("redrive notify %d", c)
+	return &api.ControlResponse{
+		Ok:      c > 0,
+		Message: fmt.Sprintf("twlogeye redrive %d notify", c),
+	}, nil
+}
+
 func (s *apiServer) SearchLog(req *api.LogRequest, stream api.TWLogEyeService_SearchLogServer) error {
 	search := req.GetSearch()
-	datastore.ForEachLog(req.GetLogtype(), req.GetStart(), req.GetEnd(), func(l *datastore.LogEnt) bool {
+	query, err := datastore.ParseLogQuery(req.GetQuery())
+	if err != nil {
+		return fmt.Errorf("invalid query err=%v", err)
+	}
+	datastore.SearchLog(req.GetLogtype(), req.GetStart(), req.GetEnd(), query, func(l *datastore.LogEnt) bool {
 		if search != "" && !strings.Contains(l.Log, search) {
 			return true
 		}
@@ -325,6 +653,8 @@ func (s *apiServer) GetNetflowReport(req *api.ReportRequest, stream api.TWLogEye
 			Flows:              int32(l.Flows),
 			Protocols:          int32(l.Protocols),
 			Fumbles:            int32(l.Fumbles),
+			Scans:              int32(l.Scans),
+			Beacons:            int32(l.Beacons),
 			TopMacPacketsList:  []*api.NetflowPacketsSummaryEnt{},
 			TopMacBytesList:    []*api.NetflowBytesSummaryEnt{},
 			TopIpPacketsList:   []*api.NetflowPacketsSummaryEnt{},
@@ -382,6 +712,40 @@ func (s *apiServer) GetNetflowReport(req *api.ReportRequest, stream api.TWLogEye
 				Count: int32(t.Count),
 			})
 		}
+		for _, t := range l.ScanList {
+			r.ScanList = append(r.ScanList, &api.NetflowScanEnt{
+				Time:      t.Time,
+				Type:      t.Type,
+				Src:       t.Src,
+				Dst:       t.Dst,
+				PortRange: t.PortRange,
+				Count:     int32(t.Count),
+				Rate:      t.Rate,
+				Targets:   t.Targets,
+			})
+		}
+		for _, t := range l.ZoneMatrix {
+			r.ZoneMatrix = append(r.ZoneMatrix, &api.NetflowZoneEnt{
+				Src:     t.Src,
+				Dst:     t.Dst,
+				Packets: t.Packets,
+				Bytes:   t.Bytes,
+				Flows:   int32(t.Flows),
+			})
+		}
+		for _, t := range l.BeaconList {
+			r.BeaconList = append(r.BeaconList, &api.NetflowBeaconEnt{
+				Time:     t.Time,
+				Src:      t.Src,
+				Dst:      t.Dst,
+				Port:     int32(t.Port),
+				Count:    int32(t.Count),
+				Interval: t.Interval,
+				Jitter:   t.Jitter,
+				Score:    t.Score,
+			})
+		}
+		r.IfList = getNetflowIfList(l.IfList)
 		if err := stream.Send(r); err != nil {
 			log.Printf("api get netflow report err=%v", err)
 			return false
@@ -408,6 +772,8 @@ func (s *apiServer) GetLastNetflowReport(ctx context.Context, req *api.Empty) (*
 		Hosts:              int32(l.Hosts),
 		Locs:               int32(l.Locs),
 		Country:            int32(l.Country),
+		Scans:              int32(l.Scans),
+		Beacons:            int32(l.Beacons),
 		TopMacPacketsList:  []*api.NetflowPacketsSummaryEnt{},
 		TopMacBytesList:    []*api.NetflowBytesSummaryEnt{},
 		TopIpPacketsList:   []*api.NetflowPacketsSummaryEnt{},
@@ -483,9 +849,73 @@ func (s *apiServer) GetLastNetflowReport(ctx context.Context, req *api.Empty) (*
 			Count: int32(t.Count),
 		})
 	}
+	for _, t := range l.ScanList {
+		r.ScanList = append(r.ScanList, &api.NetflowScanEnt{
+			Time:      t.Time,
+			Type:      t.Type,
+			Src:       t.Src,
+			Dst:       t.Dst,
+			PortRange: t.PortRange,
+			Count:     int32(t.Count),
+			Rate:      t.Rate,
+			Targets:   t.Targets,
+		})
+	}
+	for _, t := range l.BeaconList {
+		r.BeaconList = append(r.BeaconList, &api.NetflowBeaconEnt{
+			Time:     t.Time,
+			Src:      t.Src,
+			Dst:      t.Dst,
+			Port:     int32(t.Port),
+			Count:    int32(t.Count),
+			Interval: t.Interval,
+			Jitter:   t.Jitter,
+			Score:    t.Score,
+		})
+	}
+	for _, t := range l.ZoneMatrix {
+		r.ZoneMatrix = append(r.ZoneMatrix, &api.NetflowZoneEnt{
+			Src:     t.Src,
+			Dst:     t.Dst,
+			Packets: t.Packets,
+			Bytes:   t.Bytes,
+			Flows:   int32(t.Flows),
+		})
+	}
+	r.IfList = getNetflowIfList(l.IfList)
 	return r, nil
 }
 
+func getNetflowIfList(list []datastore.NetflowIfEnt) []*api.NetflowIfEnt {
+	ret := []*api.NetflowIfEnt{}
+	for _, t := range list {
+		e := &api.NetflowIfEnt{
+			Exporter:      t.Exporter,
+			IfIndex:       int32(t.IfIndex),
+			Name:          t.Name,
+			InBytes:       t.InBytes,
+			OutBytes:      t.OutBytes,
+			InPackets:     t.InPackets,
+			OutPackets:    t.OutPackets,
+			InBps:         t.InBps,
+			OutBps:        t.OutBps,
+			InPps:         t.InPps,
+			OutPps:        t.OutPps,
+			InUtil:        t.InUtil,
+			OutUtil:       t.OutUtil,
+			TopTalkerList: []*api.NetflowBytesSummaryEnt{},
+		}
+		for _, tt := range t.TopTalkerList {
+			e.TopTalkerList = append(e.TopTalkerList, &api.NetflowBytesSummaryEnt{
+				Key:   tt.Key,
+				Bytes: tt.Bytes,
+			})
+		}
+		ret = append(ret, e)
+	}
+	return ret
+}
+
 func (s *apiServer) GetWindowsEventReport(req *api.ReportRequest, stream api.TWLogEyeService_GetWindowsEventReportServer) error {
 	datastore.ForEachWindowsEventReport(req.GetStart(), req.GetEnd(), func(l *datastore.WindowsEventReportEnt) bool {
 		r := &api.WindowsEventReportEnt{
@@ -721,15 +1151,19 @@ func (s *apiServer) GetLastAnomalyReport(ctx context.Context, req *api.Empty) (*
 func (s *apiServer) GetMonitorReport(req *api.ReportRequest, stream api.TWLogEyeService_GetMonitorReportServer) error {
 	datastore.ForEachMonitorReport(req.GetStart(), req.GetEnd(), func(l *datastore.MonitorReportEnt) bool {
 		r := &api.MonitorReportEnt{
-			Time:    l.Time,
-			Cpu:     l.CPU,
-			Memory:  l.Memory,
-			Load:    l.Load,
-			Disk:    l.Disk,
-			Net:     l.Net,
-			Bytes:   l.Bytes,
-			DbSpeed: l.DBSpeed,
-			DbSize:  l.DBSize,
+			Time:     l.Time,
+			Cpu:      l.CPU,
+			Memory:   l.Memory,
+			Load:     l.Load,
+			Disk:     l.Disk,
+			Net:      l.Net,
+			Bytes:    l.Bytes,
+			DbSpeed:  l.DBSpeed,
+			DbSize:   l.DBSize,
+			Usage:    getDBUsageList(l.Usage),
+			LsmSize:  l.LSMSize,
+			VlogSize: l.VlogSize,
+			LastGc:   getDBMaintenance(l.LastGC),
 		}
 		if err := stream.Send(r); err != nil {
 			log.Printf("api get monitor report err=%v", err)
@@ -746,19 +1180,137 @@ func (s *apiServer) GetLastMonitorReport(ctx context.Context, req *api.Empty) (*
 		return nil, fmt.Errorf("monitor report not found")
 	}
 	r := &api.MonitorReportEnt{
-		Time:    l.Time,
-		Cpu:     l.CPU,
-		Memory:  l.Memory,
-		Load:    l.Load,
-		Disk:    l.Disk,
-		Net:     l.Net,
-		Bytes:   l.Bytes,
-		DbSpeed: l.DBSpeed,
-		DbSize:  l.DBSize,
+		Time:     l.Time,
+		Cpu:      l.CPU,
+		Memory:   l.Memory,
+		Load:     l.Load,
+		Disk:     l.Disk,
+		Net:      l.Net,
+		Bytes:    l.Bytes,
+		DbSpeed:  l.DBSpeed,
+		DbSize:   l.DBSize,
+		Usage:    getDBUsageList(l.Usage),
+		LsmSize:  l.LSMSize,
+		VlogSize: l.VlogSize,
+		LastGc:   getDBMaintenance(l.LastGC),
 	}
 	return r, nil
 }
 
+func getDBUsageList(list []*datastore.DBUsageEnt) []*api.DBUsageEnt {
+	ret := []*api.DBUsageEnt{}
+	for _, u := range list {
+		ret = append(ret, &api.DBUsageEnt{
+			Type:   u.Type,
+			Keys:   u.Keys,
+			Size:   u.Size,
+			Oldest: u.Oldest,
+			Newest: u.Newest,
+		})
+	}
+	return ret
+}
+
+func (s *apiServer) GetDBUsage(req *api.Empty, stream api.TWLogEyeService_GetDBUsageServer) error {
+	for _, u := range getDBUsageList(datastore.GetDBUsage()) {
+		if err := stream.Send(u); err != nil {
+			log.Printf("get db usage err=%v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func getDBMaintenance(r *datastore.DBMaintenanceEnt) *api.DBMaintenanceEnt {
+	if r == nil {
+		return nil
+	}
+	return &api.DBMaintenanceEnt{
+		Time:     r.Time,
+		Duration: r.Duration,
+		Flatten:  r.Flatten,
+		GcCount:  r.GCCount,
+		Before:   r.Before,
+		After:    r.After,
+		Error:    r.Error,
+	}
+}
+
+func (s *apiServer) GetDBStats(ctx context.Context, req *api.Empty) (*api.DBStatsResponse, error) {
+	r := datastore.GetDBStats()
+	return &api.DBStatsResponse{
+		LsmSize:  r.LSMSize,
+		VlogSize: r.VlogSize,
+		Usage:    getDBUsageList(r.Usage),
+		LastGc:   getDBMaintenance(r.LastGC),
+	}, nil
+}
+
+func (s *apiServer) CompactDB(ctx context.Context, req *api.Empty) (*api.DBMaintenanceEnt, error) {
+	r, err := datastore.RunDBMaintenance(true)
+	if err != nil {
+		return nil, err
+	}
+	return getDBMaintenance(r), nil
+}
+
+// backupChunkSize : max size of backup chunk (under 4MB default max message size)
+const backupChunkSize = 1024 * 1024
+
+type backupStreamWriter struct {
+	stream api.TWLogEyeService_BackupDBServer
+}
+
+func (w *backupStreamWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		c := min(len(p), backupChunkSize)
+		if err := w.stream.Send(&api.BackupChunk{Data: p[:c]}); err != nil {
+			return n, err
+		}
+		n += c
+		p = p[c:]
+	}
+	return n, nil
+}
+
+func (s *apiServer) BackupDB(req *api.BackupRequest, stream api.TWLogEyeService_BackupDBServer) error {
+	var m *datastore.BackupManifestEnt
+	var err error
+	path := ""
+	if req.GetPath() != "" {
+		path, err = datastore.GetBackupPath(req.GetPath())
+		if err != nil {
+			return err
+		}
+		var f *os.File
+		f, err = os.Create(path)
+		if err != nil {
+			return err
+		}
+		m, err = datastore.BackupDB(f, req.GetSince())
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	} else {
+		w := bufio.NewWriterSize(&backupStreamWriter{stream: stream}, backupChunkSize)
+		m, err = datastore.BackupDB(w, req.GetSince())
+		if err == nil {
+			err = w.Flush()
+		}
+	}
+	if err != nil {
+		log.Printf("backup db err=%v", err)
+		return err
+	}
+	j, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	log.Printf("backup db since=%d until=%d path=%s", m.Since, m.Until, path)
+	return stream.Send(&api.BackupChunk{Manifest: string(j)})
+}
+
 func (s *apiServer) GetOTelMetricList(req *api.Empty, stream api.TWLogEyeService_GetOTelMetricListServer) error {
 	datastore.ForEachOTelMetric(func(id string, m *datastore.OTelMetricEnt) bool {
 		r := &api.OTelMetricListEnt{
@@ -2,6 +2,10 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
 	"net"
 	"testing"
 	"time"
@@ -80,6 +84,45 @@ func TestGRPCServer(t *testing.T) {
 		t.Errorf("expected 'test message', got %s", resp.Log)
 	}
 
+	// Test SearchLog with query
+	datastore.SaveLogs("syslog", []*datastore.LogEnt{
+		{Time: now + 1, Type: datastore.Syslog, Src: "192.168.1.1", Log: `{"hostname":"fw01","severity":2,"tag":"sshd"}`},
+		{Time: now + 2, Type: datastore.Syslog, Src: "192.168.1.2", Log: `{"hostname":"fw02","severity":6,"tag":"cron"}`},
+	})
+	stream4, err := client.SearchLog(ctx, &api.LogRequest{
+		Logtype: "syslog",
+		Start:   0,
+		End:     now + 3,
+		Query:   "severity<=3 AND hostname:fw* AND NOT tag:cron",
+	})
+	if err != nil {
+		t.Fatalf("SearchLog RPC failed: %v", err)
+	}
+	count := 0
+	for {
+		resp, err := stream4.Recv()
+		if err != nil {
+			break
+		}
+		if resp.Src != "192.168.1.1" {
+			t.Errorf("unexpected log %s", resp.Log)
+		}
+		count++
+	}
+	if count != 1 {
+		t.Errorf("expected 1 log by query, got %d", count)
+	}
+	stream5, err := client.SearchLog(ctx, &api.LogRequest{
+		Logtype: "syslog",
+		Query:   "(tag:cron",
+	})
+	if err != nil {
+		t.Fatalf("SearchLog RPC failed: %v", err)
+	}
+	if _, err := stream5.Recv(); err == nil || err == io.EOF {
+		t.Errorf("expected invalid query error, got %v", err)
+	}
+
 	// Test ClearDB
 	clearResp, err := client.ClearDB(ctx, &api.ClearRequest{
 		Type:    "logs",
@@ -118,3 +161,75 @@ func TestGetLastSyslogReport_NotFound(t *testing.T) {
 		t.Error("expected error for missing report, got nil")
 	}
 }
+
+func TestBackupDB(t *testing.T) {
+	datastore.Config.DBPath = ""
+	datastore.Config.LogRetention = 24
+	datastore.OpenDB()
+	defer datastore.CloseDB()
+	// Save more than 4MB (default max message size of gRPC client) of random logs
+	now := time.Now().UnixNano()
+	logs := []*datastore.LogEnt{}
+	for i := 0; i < 3000; i++ {
+		b := make([]byte, 1024)
+		rand.Read(b)
+		logs = append(logs, &datastore.LogEnt{Time: now + int64(i), Type: datastore.Syslog, Src: "127.0.0.1", Log: hex.EncodeToString(b)})
+	}
+	datastore.SaveLogs("syslog", logs)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	api.RegisterTWLogEyeServiceServer(s, NewAPIServer())
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := api.NewTWLogEyeServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.BackupDB(ctx, &api.BackupRequest{})
+	if err != nil {
+		t.Fatalf("BackupDB RPC failed: %v", err)
+	}
+	size := 0
+	manifest := ""
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("BackupDB Recv failed: %v", err)
+		}
+		if len(r.GetData()) > backupChunkSize {
+			t.Errorf("too large chunk %d", len(r.GetData()))
+		}
+		size += len(r.GetData())
+		if r.GetManifest() != "" {
+			manifest = r.GetManifest()
+		}
+	}
+	if size < 4*1024*1024 || manifest == "" {
+		t.Errorf("invalid backup size=%d manifest=%s", size, manifest)
+	}
+
+	// Backup file on server is only in backupDir
+	for _, path := range []string{"../x.bak", "/tmp/x.bak"} {
+		datastore.Config.BackupDir = t.TempDir()
+		stream, err := client.BackupDB(ctx, &api.BackupRequest{Path: path})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if err == nil {
+			t.Errorf("backup to %s must be error", path)
+		}
+	}
+	datastore.Config.BackupDir = ""
+}